@@ -10,8 +10,11 @@ import (
 
 // ACMatcher implements the Aho-Corasick algorithm for multi-pattern matching
 type ACMatcher struct {
-	root          *Node // Root of the trie
-	caseSensitive bool  // Whether matching is case-sensitive
+	root              *Node                   // Root of the trie
+	caseSensitive     bool                    // Whether matching is case-sensitive
+	substitutions     algorithm.Substitutions // Input sequences accepted for dictionary characters
+	substitutionLimit int                     // Maximum spellings compiled per word
+	maxRun            int                     // Longest run of one rune collapsed (0 disables)
 }
 
 // NewACMatcher creates a new AC matcher instance
@@ -22,6 +25,21 @@ func NewACMatcher(caseSensitive bool) *ACMatcher {
 	}
 }
 
+// SetSubstitutions lets each dictionary character also match the input sequences
+// of table, such as "4" for 'a' or "ph" for 'f'
+// It must be called before Build; limit bounds the spellings compiled per word
+func (m *ACMatcher) SetSubstitutions(table algorithm.Substitutions, limit int) {
+	m.substitutions = table
+	m.substitutionLimit = limit
+}
+
+// SetMaxRun lets a run of up to maxRun identical runes match one occurrence of
+// that rune where the dictionary word does not repeat it, such as "baaad" for "bad"
+// It must be called before Build; a maxRun of 0 disables collapsing
+func (m *ACMatcher) SetMaxRun(maxRun int) {
+	m.maxRun = maxRun
+}
+
 // Build constructs the AC automaton from the given words
 func (m *ACMatcher) Build(words []dict.Word) error {
 	// Build the trie
@@ -29,6 +47,13 @@ func (m *ACMatcher) Build(words []dict.Word) error {
 		m.insert(&words[i])
 	}
 
+	// Substituted spellings never replace a word or an earlier spelling
+	if len(m.substitutions) > 0 {
+		for i := range words {
+			m.insertSubstituted(&words[i])
+		}
+	}
+
 	// Build failure pointers using BFS
 	m.buildFailurePointers()
 
@@ -37,21 +62,75 @@ func (m *ACMatcher) Build(words []dict.Word) error {
 
 // insert adds a word to the trie
 func (m *ACMatcher) insert(word *dict.Word) {
-	node := m.root
-	text := word.Text
+	if m.maxRun > 0 {
+		m.insertCollapsed(m.normalize(word.Text), word, true)
+		return
+	}
+	m.path(m.normalize(word.Text)).setWord(word)
+}
 
-	// Convert to lowercase if case-insensitive
-	if !m.caseSensitive {
-		text = strings.ToLower(text)
+// insertCollapsed adds a spelling under its collapsed form; an identical spelling
+// already there is replaced only with replace
+func (m *ACMatcher) insertCollapsed(spelling string, word *dict.Word, replace bool) {
+	collapsed, runs := algorithm.CollapseRuns([]rune(spelling), m.maxRun)
+	node := m.path(string(collapsed))
+	node.isEnd = true
+
+	for i, existing := range node.runWords {
+		if equalRuns(existing.runs, runs) {
+			if replace {
+				node.runWords[i].word = word
+			}
+			return
+		}
 	}
+	node.runWords = append(node.runWords, runWord{word: word, runs: runs})
+}
 
-	runes := []rune(text)
+// equalRuns reports whether two spellings of one collapsed form have the same runs
+func equalRuns(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
 
-	for _, r := range runes {
-		node = node.addChild(r)
+// insertSubstituted adds the substituted spellings of a word to the trie
+func (m *ACMatcher) insertSubstituted(word *dict.Word) {
+	spellings := m.substitutions.Expand(m.normalize(word.Text), m.substitutionLimit)
+	if len(spellings) < 2 {
+		return
 	}
 
-	node.setWord(word)
+	derived := algorithm.Substituted(word)
+	for _, spelling := range spellings[1:] {
+		if m.maxRun > 0 {
+			m.insertCollapsed(m.normalize(spelling), derived, false)
+			continue
+		}
+		if node := m.path(m.normalize(spelling)); !node.isEnd {
+			node.setWord(derived)
+		}
+	}
+}
+
+// normalize converts text to lowercase if case-insensitive
+func (m *ACMatcher) normalize(text string) string {
+	if !m.caseSensitive {
+		return strings.ToLower(text)
+	}
+	return text
+}
+
+// path returns the node reached by text, creating missing nodes
+func (m *ACMatcher) path(text string) *Node {
+	node := m.root
+	for _, r := range text {
+		node = node.addChild(r)
+	}
+	return node
 }
 
 // buildFailurePointers constructs failure pointers for the AC automaton
@@ -99,6 +178,9 @@ func (m *ACMatcher) Match(text string) []algorithm.MatchResult {
 	}
 
 	runes := []rune(text)
+	if m.maxRun > 0 {
+		return m.matchCollapsed(runes, false)
+	}
 	node := m.root
 
 	for i, r := range runes {
@@ -119,13 +201,17 @@ func (m *ACMatcher) Match(text string) []algorithm.MatchResult {
 		tempNode := node
 		for tempNode != m.root {
 			if tempNode.isEnd && tempNode.word != nil {
-				wordLen := len([]rune(tempNode.word.Text))
+				// The depth, not the word, gives the length of substituted spellings
 				results = append(results, algorithm.MatchResult{
-					Word:     tempNode.word.Text,
-					Start:    i - wordLen + 1,
+					Word:     tempNode.word.Canonical(),
+					Start:    i - tempNode.depth + 1,
 					End:      i + 1,
 					Category: tempNode.word.Category,
 					Level:    tempNode.word.Level,
+					Weight:   tempNode.word.Weight,
+					Tags:     tempNode.word.Tags,
+					Source:   tempNode.word.Source,
+					Variant:  tempNode.word.Variant,
 				})
 			}
 			tempNode = tempNode.fail
@@ -172,6 +258,9 @@ func (m *ACMatcher) Validate(text string) bool {
 	}
 
 	runes := []rune(text)
+	if m.maxRun > 0 {
+		return len(m.matchCollapsed(runes, true)) == 0
+	}
 	node := m.root
 
 	for _, r := range runes {
@@ -200,3 +289,51 @@ func (m *ACMatcher) Validate(text string) bool {
 
 	return true // No sensitive words found
 }
+
+// matchCollapsed runs the automaton once over the text with its runs of repeated
+// runes collapsed, keeping the offset of every run to report spans in the text;
+// with first it stops at the first match
+func (m *ACMatcher) matchCollapsed(runes []rune, first bool) []algorithm.MatchResult {
+	collapsed, runs := algorithm.CollapseRuns(runes, m.maxRun)
+	offsets := make([]int, len(runs)+1)
+	for k, run := range runs {
+		offsets[k+1] = offsets[k] + run
+	}
+
+	var results []algorithm.MatchResult
+	node := m.root
+
+	for k, r := range collapsed {
+		for node != m.root && !node.hasChild(r) {
+			node = node.fail
+		}
+		if child, exists := node.getChild(r); exists {
+			node = child
+		} else {
+			node = m.root
+			continue
+		}
+
+		for tempNode := node; tempNode != m.root; tempNode = tempNode.fail {
+			start := k - tempNode.depth + 1
+			for _, entry := range tempNode.runWords {
+				ok, stretched := entry.fits(runs[start : k+1])
+				if !ok {
+					continue
+				}
+
+				// Spans cover whole runs, so "bbbadddd" masks every repeat
+				result := algorithm.Stretched(entry.word, offsets[start], offsets[k+1])
+				if !stretched {
+					result.Variant = entry.word.Variant
+				}
+				results = append(results, result)
+				if first {
+					return results
+				}
+			}
+		}
+	}
+
+	return results
+}
@@ -1,8 +1,10 @@
 package ac
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/Karrecy/sensitive-go/algorithm"
 	"github.com/Karrecy/sensitive-go/dict"
 )
 
@@ -122,6 +124,96 @@ func TestACMatcher_Validate(t *testing.T) {
 	}
 }
 
+func TestACMatcher_Substitutions(t *testing.T) {
+	matcher := NewACMatcher(false)
+	matcher.SetSubstitutions(algorithm.DefaultSubstitutions(), 0)
+	words := []dict.Word{
+		{Text: "badword", Category: dict.CategoryAbuse, Level: dict.LevelHigh},
+		{Text: "shit", Category: dict.CategoryAbuse, Level: dict.LevelHigh},
+		{Text: "fake", Category: dict.CategoryAd, Level: dict.LevelLow},
+		{Text: "sh1t", Category: dict.CategoryOther, Level: dict.LevelLow},
+	}
+	if err := matcher.Build(words); err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		text    string
+		word    string
+		start   int
+		end     int
+		variant string
+	}{
+		{"you b4dw0rd", "badword", 4, 11, algorithm.VariantSubstitution},
+		{"oh $h1t!", "shit", 3, 7, algorithm.VariantSubstitution},
+		{"a PHake one", "fake", 2, 7, algorithm.VariantSubstitution},
+		{"sh1t happens", "sh1t", 0, 4, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			matches := matcher.Match(tt.text)
+			if len(matches) != 1 {
+				t.Fatalf("Expected 1 match, got %+v", matches)
+			}
+			m := matches[0]
+			if m.Word != tt.word || m.Start != tt.start || m.End != tt.end || m.Variant != tt.variant {
+				t.Errorf("Expected %s [%d,%d) %q, got %s [%d,%d) %q", tt.word, tt.start, tt.end, tt.variant, m.Word, m.Start, m.End, m.Variant)
+			}
+		})
+	}
+
+	// The limit bounds the spellings compiled per word
+	if spellings := algorithm.DefaultSubstitutions().Expand("assassinations", 10); len(spellings) != 10 || spellings[0] != "assassinations" {
+		t.Errorf("Expected 10 spellings starting with the word, got %v", spellings)
+	}
+}
+
+func TestACMatcher_Repeat(t *testing.T) {
+	matcher := NewACMatcher(false)
+	matcher.SetMaxRun(4)
+	words := []dict.Word{
+		{Text: "bad", Category: dict.CategoryAbuse, Level: dict.LevelHigh},
+		{Text: "傻比", Category: dict.CategoryAbuse, Level: dict.LevelHigh},
+		{Text: "good", Category: dict.CategoryOther, Level: dict.LevelLow},
+	}
+	if err := matcher.Build(words); err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []algorithm.MatchResult
+	}{
+		{"Stretched vowel", "so baaaad", []algorithm.MatchResult{{Word: "bad", Start: 3, End: 9, Variant: algorithm.VariantRepeat}}},
+		{"Whole runs", "bbbadddd!", []algorithm.MatchResult{{Word: "bad", Start: 0, End: 8, Variant: algorithm.VariantRepeat}}},
+		{"Chinese", "傻傻傻比", []algorithm.MatchResult{{Word: "傻比", Start: 0, End: 4, Variant: algorithm.VariantRepeat}}},
+		{"Literal", "bad", []algorithm.MatchResult{{Word: "bad", Start: 0, End: 3}}},
+		{"Repetition in the word", "gooood", []algorithm.MatchResult{{Word: "good", Start: 0, End: 6, Variant: algorithm.VariantRepeat}}},
+		{"Run too long", "baaaaad", nil},
+		{"Fewer repeats than the word", "god", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := matcher.Match(tt.text)
+			if len(matches) != len(tt.expected) {
+				t.Fatalf("Expected %d matches, got %+v", len(tt.expected), matches)
+			}
+			for i, m := range matches {
+				want := tt.expected[i]
+				if m.Word != want.Word || m.Start != want.Start || m.End != want.End || m.Variant != want.Variant {
+					t.Errorf("Expected %+v, got %+v", want, m)
+				}
+			}
+			if matcher.Validate(tt.text) != (len(tt.expected) == 0) {
+				t.Errorf("Validate disagrees with Match for %q", tt.text)
+			}
+		})
+	}
+}
+
 func BenchmarkACMatcher_Match(b *testing.B) {
 	matcher := NewACMatcher(true) // case-sensitive
 	words := make([]dict.Word, 1000)
@@ -142,3 +234,23 @@ func BenchmarkACMatcher_Match(b *testing.B) {
 	}
 }
 
+func BenchmarkACMatcher_MatchRepeat(b *testing.B) {
+	matcher := NewACMatcher(true)
+	matcher.SetMaxRun(algorithm.DefaultMaxRun)
+	words := make([]dict.Word, 1000)
+	for i := 0; i < 1000; i++ {
+		words[i] = dict.Word{
+			Text:     "测试词" + string(rune(i)),
+			Category: dict.CategoryOther,
+			Level:    dict.LevelLow,
+		}
+	}
+
+	matcher.Build(words)
+	text := strings.Repeat("这是一段包含测测测试词词100的文本内容，用于性能基准测试。", 20)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matcher.Match(text)
+	}
+}
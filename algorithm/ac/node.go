@@ -8,6 +8,28 @@ type Node struct {
 	fail     *Node          // Failure pointer for AC automation
 	word     *dict.Word     // The word if this is a terminal node
 	isEnd    bool           // Whether this node marks the end of a word
+	depth    int            // Number of runes from the root
+	runWords []runWord      // Words whose collapsed form ends here
+}
+
+// runWord is a dictionary spelling with its runs of repeated runes collapsed
+type runWord struct {
+	word *dict.Word // The word reported for the spelling
+	runs []int      // Length of each run of the spelling
+}
+
+// fits reports whether text runs can spell the word, each at least as long as
+// the word's own run, and whether any of them is longer
+func (w runWord) fits(runs []int) (ok, stretched bool) {
+	for i, need := range w.runs {
+		if runs[i] < need {
+			return false, false
+		}
+		if runs[i] > need {
+			stretched = true
+		}
+	}
+	return true, stretched
 }
 
 // newNode creates a new trie node
@@ -26,6 +48,7 @@ func (n *Node) addChild(r rune) *Node {
 		return child
 	}
 	child := newNode()
+	child.depth = n.depth + 1
 	n.children[r] = child
 	return child
 }
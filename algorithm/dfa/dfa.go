@@ -10,8 +10,11 @@ import (
 
 // DFAMatcher implements a Deterministic Finite Automaton for pattern matching
 type DFAMatcher struct {
-	root          *State // Root state of the DFA
-	caseSensitive bool   // Whether matching is case-sensitive
+	root              *State                  // Root state of the DFA
+	caseSensitive     bool                    // Whether matching is case-sensitive
+	substitutions     algorithm.Substitutions // Input sequences accepted for dictionary characters
+	substitutionLimit int                     // Maximum spellings compiled per word
+	maxRun            int                     // Longest run of one rune collapsed (0 disables)
 }
 
 // NewDFAMatcher creates a new DFA matcher instance
@@ -22,27 +25,68 @@ func NewDFAMatcher(caseSensitive bool) *DFAMatcher {
 	}
 }
 
+// SetSubstitutions lets each dictionary character also match the input sequences
+// of table, such as "4" for 'a' or "ph" for 'f'
+// It must be called before Build; limit bounds the spellings compiled per word
+func (m *DFAMatcher) SetSubstitutions(table algorithm.Substitutions, limit int) {
+	m.substitutions = table
+	m.substitutionLimit = limit
+}
+
+// SetMaxRun lets a run of up to maxRun identical runes match one occurrence of
+// that rune where the dictionary word does not repeat it, such as "baaad" for "bad"
+// A maxRun of 0 disables collapsing
+func (m *DFAMatcher) SetMaxRun(maxRun int) {
+	m.maxRun = maxRun
+}
+
 // Build constructs the DFA from the given words
 func (m *DFAMatcher) Build(words []dict.Word) error {
 	for i := range words {
 		m.insert(&words[i])
 	}
+
+	// Substituted spellings never replace a word or an earlier spelling
+	if len(m.substitutions) > 0 {
+		for i := range words {
+			m.insertSubstituted(&words[i])
+		}
+	}
 	return nil
 }
 
 // insert adds a word to the DFA
 func (m *DFAMatcher) insert(word *dict.Word) {
-	state := m.root
-	text := word.Text
+	m.path(m.normalize(word.Text)).setWord(word)
+}
 
-	// Convert to lowercase if case-insensitive
-	if !m.caseSensitive {
-		text = strings.ToLower(text)
+// insertSubstituted adds the substituted spellings of a word to the DFA
+func (m *DFAMatcher) insertSubstituted(word *dict.Word) {
+	spellings := m.substitutions.Expand(m.normalize(word.Text), m.substitutionLimit)
+	if len(spellings) < 2 {
+		return
 	}
 
-	runes := []rune(text)
+	derived := algorithm.Substituted(word)
+	for _, spelling := range spellings[1:] {
+		if state := m.path(m.normalize(spelling)); !state.isEndState() {
+			state.setWord(derived)
+		}
+	}
+}
+
+// normalize converts text to lowercase if case-insensitive
+func (m *DFAMatcher) normalize(text string) string {
+	if !m.caseSensitive {
+		return strings.ToLower(text)
+	}
+	return text
+}
 
-	for _, r := range runes {
+// path returns the state reached by text, creating missing states
+func (m *DFAMatcher) path(text string) *State {
+	state := m.root
+	for _, r := range text {
 		if next, exists := state.transition(r); exists {
 			state = next
 		} else {
@@ -51,8 +95,7 @@ func (m *DFAMatcher) insert(word *dict.Word) {
 			state = nextState
 		}
 	}
-
-	state.setWord(word)
+	return state
 }
 
 // Match finds all sensitive words in the text
@@ -69,6 +112,7 @@ func (m *DFAMatcher) Match(text string) []algorithm.MatchResult {
 	for i := 0; i < len(runes); i++ {
 		state := m.root
 		j := i
+		run, stretched := 0, 0
 
 		// Try to match from position i
 		for j < len(runes) {
@@ -77,25 +121,43 @@ func (m *DFAMatcher) Match(text string) []algorithm.MatchResult {
 				break
 			}
 
+			if j > i && runes[j] == runes[j-1] {
+				run++
+			} else {
+				run = 1
+			}
 			state = next
 			j++
 
+			// Absorb repeats the word cannot consume, so the span covers the whole run
+			skipped := m.stretch(runes, j, run, state)
+			j, run, stretched = j+skipped, run+skipped, stretched+skipped
+
 			// Check if we've reached an end state
 			if state.isEndState() {
 				word := state.getWord()
-				if word != nil {
+				if word != nil && stretched > 0 {
+					results = append(results, algorithm.Stretched(word, i, j))
+				} else if word != nil {
 					results = append(results, algorithm.MatchResult{
-						Word:     word.Text,
+						Word:     word.Canonical(),
 						Start:    i,
 						End:      j,
 						Category: word.Category,
 						Level:    word.Level,
+						Weight:   word.Weight,
+						Tags:     word.Tags,
+						Source:   word.Source,
+						Variant:  word.Variant,
 					})
 				}
 			}
 		}
 	}
 
+	if m.maxRun > 0 {
+		results = algorithm.LongestRuns(results)
+	}
 	return results
 }
 
@@ -140,6 +202,7 @@ func (m *DFAMatcher) Validate(text string) bool {
 	for i := 0; i < len(runes); i++ {
 		state := m.root
 		j := i
+		run := 0
 
 		// Try to match from position i
 		for j < len(runes) {
@@ -148,9 +211,17 @@ func (m *DFAMatcher) Validate(text string) bool {
 				break
 			}
 
+			if j > i && runes[j] == runes[j-1] {
+				run++
+			} else {
+				run = 1
+			}
 			state = next
 			j++
 
+			skipped := m.stretch(runes, j, run, state)
+			j, run = j+skipped, run+skipped
+
 			// If we find a match, text is invalid
 			if state.isEndState() {
 				return false
@@ -160,3 +231,21 @@ func (m *DFAMatcher) Validate(text string) bool {
 
 	return true // No sensitive words found
 }
+
+// stretch returns how many repeats of runes[j-1] from j on are collapsed into it
+// Repeats are collapsed while state cannot consume the rune itself and the run,
+// of length run so far, stays within maxRun
+func (m *DFAMatcher) stretch(runes []rune, j, run int, state *State) int {
+	if m.maxRun == 0 || j == len(runes) || runes[j] != runes[j-1] {
+		return 0
+	}
+	if _, exists := state.transition(runes[j]); exists {
+		return 0
+	}
+
+	skipped := 0
+	for j+skipped < len(runes) && runes[j+skipped] == runes[j-1] && run+skipped < m.maxRun {
+		skipped++
+	}
+	return skipped
+}
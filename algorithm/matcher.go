@@ -24,6 +24,10 @@ type MatchResult struct {
 	End      int           // End position (rune index)
 	Category dict.Category // Category of the word
 	Level    dict.Level    // Severity level
+	Weight   float64       // Risk weight of the word (0 means unset)
+	Tags     []string      // Tags of the word, shared with the dictionary
+	Source   string        // Source the word was loaded from
+	Variant  string        // Variant of the word that matched, such as "pinyin" (empty for the word itself)
 }
 
 // AlgorithmType represents the type of matching algorithm
@@ -0,0 +1,87 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// VariantRepeat is the variant reported for matches that collapsed repeated characters
+const VariantRepeat = "repeat"
+
+// DefaultMaxRun is the default length of the longest run of one character that is collapsed
+const DefaultMaxRun = 16
+
+// Stretched returns the result for a match of word spanning [start, end) that
+// collapsed repeated characters
+func Stretched(word *dict.Word, start, end int) MatchResult {
+	variant := word.Variant
+	if variant == "" {
+		variant = VariantRepeat
+	}
+	return MatchResult{
+		Word:     word.Canonical(),
+		Start:    start,
+		End:      end,
+		Category: word.Category,
+		Level:    word.Level,
+		Weight:   word.Weight,
+		Tags:     word.Tags,
+		Source:   word.Source,
+		Variant:  variant,
+	}
+}
+
+// LongestRuns drops matches covered by a longer match of the same word, such as
+// "bad" at [2, 5) inside the stretched "bbbadddd" at [0, 8)
+func LongestRuns(results []MatchResult) []MatchResult {
+	// Ordered by start, longest first, a match is covered exactly when an earlier
+	// match of the same word reaches at least as far
+	order := make([]int, len(results))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		x, y := results[order[a]], results[order[b]]
+		if x.Start != y.Start {
+			return x.Start < y.Start
+		}
+		return x.End > y.End
+	})
+
+	covered := make([]bool, len(results))
+	reach := make(map[string]int)
+	for _, i := range order {
+		r := results[i]
+		if end, ok := reach[r.Word]; ok && end >= r.End {
+			covered[i] = true
+			continue
+		}
+		reach[r.Word] = r.End
+	}
+
+	kept := make([]MatchResult, 0, len(results))
+	for i, r := range results {
+		if !covered[i] {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// CollapseRuns replaces each run of one rune with a single rune and returns the
+// length of every run; a run longer than maxRun is split into runs of at most maxRun
+func CollapseRuns(runes []rune, maxRun int) ([]rune, []int) {
+	collapsed := make([]rune, 0, len(runes))
+	runs := make([]int, 0, len(runes))
+	for i, r := range runes {
+		last := len(runs) - 1
+		if i > 0 && r == runes[i-1] && runs[last] < maxRun {
+			runs[last]++
+			continue
+		}
+		collapsed = append(collapsed, r)
+		runs = append(runs, 1)
+	}
+	return collapsed, runs
+}
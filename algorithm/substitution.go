@@ -0,0 +1,141 @@
+package algorithm
+
+import (
+	"math"
+	"unicode/utf8"
+
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// VariantSubstitution is the variant reported for matches of a substituted spelling
+const VariantSubstitution = "substitution"
+
+// DefaultSubstitutionLimit is the default maximum number of spellings compiled per word
+const DefaultSubstitutionLimit = 64
+
+// Substitutions maps a dictionary character to the input sequences that may
+// stand for it, such as 'a' -> "4" and 'f' -> "ph"
+type Substitutions map[rune][]string
+
+// DefaultSubstitutions returns common leetspeak substitutions for Latin letters
+func DefaultSubstitutions() Substitutions {
+	return Substitutions{
+		'a': {"4", "@"},
+		'b': {"8", "|3"},
+		'c': {"("},
+		'e': {"3"},
+		'f': {"ph"},
+		'g': {"9", "6"},
+		'h': {"#"},
+		'i': {"1", "!", "|"},
+		'l': {"1", "|"},
+		'o': {"0"},
+		's': {"5", "$"},
+		't': {"7", "+"},
+		'z': {"2"},
+	}
+}
+
+// Expand returns the spellings of text under the table: text itself first, then
+// spellings with one substitution, then two, and so on
+// At most limit spellings are returned (DefaultSubstitutionLimit when limit is 0
+// or less), which bounds the states a word adds to a matcher
+func (s Substitutions) Expand(text string, limit int) []string {
+	if limit <= 0 {
+		limit = DefaultSubstitutionLimit
+	}
+
+	runes := []rune(text)
+	options := make([][]string, len(runes))
+	for i, r := range runes {
+		for _, sequence := range s[r] {
+			if sequence != "" && sequence != string(r) {
+				options[i] = append(options[i], sequence)
+			}
+		}
+	}
+
+	// after[i] is the number of substitutable positions from i on
+	after := make([]int, len(runes)+1)
+	for i := len(runes) - 1; i >= 0; i-- {
+		after[i] = after[i+1]
+		if len(options[i]) > 0 {
+			after[i]++
+		}
+	}
+
+	spellings := []string{text}
+	seen := map[string]bool{text: true}
+
+	buf := make([]byte, 0, len(text)*2)
+	var expand func(pos, remaining int)
+	expand = func(pos, remaining int) {
+		if len(spellings) >= limit || remaining > after[pos] {
+			return
+		}
+		if pos == len(runes) {
+			if remaining == 0 && !seen[string(buf)] {
+				seen[string(buf)] = true
+				spellings = append(spellings, string(buf))
+			}
+			return
+		}
+
+		mark := len(buf)
+		if remaining <= after[pos+1] {
+			buf = utf8.AppendRune(buf, runes[pos])
+			expand(pos+1, remaining)
+			buf = buf[:mark]
+		}
+		if remaining > 0 {
+			for _, sequence := range options[pos] {
+				buf = append(buf, sequence...)
+				expand(pos+1, remaining-1)
+				buf = buf[:mark]
+			}
+		}
+	}
+
+	for count := 1; count <= after[0] && len(spellings) < limit; count++ {
+		expand(0, count)
+	}
+	return spellings
+}
+
+// Count returns the number of spellings of text under the table, text included,
+// before any limit; it saturates at math.MaxInt
+func (s Substitutions) Count(text string) int {
+	count := 1
+	for _, r := range text {
+		options := 1
+		for _, sequence := range s[r] {
+			if sequence != "" && sequence != string(r) {
+				options++
+			}
+		}
+		if count > math.MaxInt/options {
+			return math.MaxInt
+		}
+		count *= options
+	}
+	return count
+}
+
+// Truncated reports whether Expand drops spellings of text at limit
+// (DefaultSubstitutionLimit when limit is 0 or less)
+func (s Substitutions) Truncated(text string, limit int) bool {
+	if limit <= 0 {
+		limit = DefaultSubstitutionLimit
+	}
+	return s.Count(text) > limit
+}
+
+// Substituted returns the word reported for a substituted spelling of word
+func Substituted(word *dict.Word) *dict.Word {
+	derived := *word
+	derived.Base = word.Canonical()
+	if derived.Variant == "" {
+		derived.Variant = VariantSubstitution
+	}
+	return &derived
+}
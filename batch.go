@@ -0,0 +1,118 @@
+package gosensitive
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// BatchOptions configures FindBatch
+type BatchOptions struct {
+	// Workers is the number of texts processed concurrently (default runtime.GOMAXPROCS(0))
+	Workers int
+
+	// ItemTimeout limits the time spent on one text (0 means no limit)
+	// A timed-out text is reported with context.DeadlineExceeded; its detection
+	// finishes in the background and the result is discarded
+	ItemTimeout time.Duration
+}
+
+// BatchResult is the outcome for one text of a batch
+type BatchResult struct {
+	Index  int     // Position of the text in the batch
+	Result *Result // Detection result (nil when Err is set)
+	Err    error   // Timeout, cancellation or panic while processing the text
+}
+
+// FindBatch runs FindAll over texts on a bounded worker pool
+// Results are returned in input order. Texts not started before ctx is done
+// are reported with ctx.Err(), which is also returned
+func (d *Detector) FindBatch(ctx context.Context, texts []string, opts BatchOptions) ([]BatchResult, error) {
+	results := make([]BatchResult, len(texts))
+	if len(texts) == 0 {
+		return results, ctx.Err()
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(texts) {
+		workers = len(texts)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = d.findItem(ctx, i, texts[i], opts.ItemTimeout)
+			}
+		}()
+	}
+
+	next := 0
+feed:
+	for ; next < len(texts); next++ {
+		select {
+		case indexes <- next:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	// Texts that were never handed to a worker
+	for i := next; i < len(texts); i++ {
+		results[i] = BatchResult{Index: i, Err: ctx.Err()}
+	}
+
+	if next < len(texts) {
+		return results, ctx.Err()
+	}
+	return results, nil
+}
+
+// findItem processes one text of a batch, enforcing the item timeout
+func (d *Detector) findItem(ctx context.Context, index int, text string, timeout time.Duration) BatchResult {
+	if err := ctx.Err(); err != nil {
+		return BatchResult{Index: index, Err: err}
+	}
+
+	if timeout <= 0 {
+		result, err := d.safeFindAll(text)
+		return BatchResult{Index: index, Result: result, Err: err}
+	}
+
+	itemCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan BatchResult, 1)
+	go func() {
+		result, err := d.safeFindAll(text)
+		done <- BatchResult{Index: index, Result: result, Err: err}
+	}()
+
+	select {
+	case result := <-done:
+		return result
+	case <-itemCtx.Done():
+		return BatchResult{Index: index, Err: itemCtx.Err()}
+	}
+}
+
+// safeFindAll runs FindAll and turns a panic, for example in a custom filter, into an error
+func (d *Detector) safeFindAll(text string) (result *Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, fmt.Errorf("panic during detection: %v", r)
+		}
+	}()
+	return d.FindAll(text), nil
+}
@@ -1,9 +1,9 @@
 package gosensitive
 
 import (
+	"fmt"
+
 	"github.com/Karrecy/sensitive-go/algorithm"
-	"github.com/Karrecy/sensitive-go/algorithm/ac"
-	"github.com/Karrecy/sensitive-go/algorithm/dfa"
 	"github.com/Karrecy/sensitive-go/builtin"
 	"github.com/Karrecy/sensitive-go/dict"
 	"github.com/Karrecy/sensitive-go/filter"
@@ -14,23 +14,24 @@ import (
 // Builder provides a fluent API for constructing a Detector
 type Builder struct {
 	algorithmType    AlgorithmType
-	words            []dict.Word
-	loaders          []loader.Loader
+	sources          []loader.Source // Prioritized word sources
 	options          *Options
 	whitelist        []string
-	whitelistLoaders []loader.Loader      // Loaders for whitelist
-	fileLoaders      []*loader.FileLoader // Track file loaders for watching
+	taggedWhitelist  []dict.Word            // Whitelist words limited to tags
+	whitelistLoaders []loader.Source        // Loaders for whitelist
+	fileLoaders      []*loader.FileLoader   // Track file loaders for watching
+	similarLoaders   []loader.SimilarLoader // Loaders for similar character rules
+	rules            *RuleSet               // Moderation rules for Decide
 }
 
 // New creates a new Builder with default settings
 func New() *Builder {
 	return &Builder{
 		algorithmType:    AlgorithmAuto,
-		words:            make([]dict.Word, 0),
-		loaders:          make([]loader.Loader, 0),
+		sources:          make([]loader.Source, 0),
 		options:          DefaultOptions(),
 		whitelist:        make([]string, 0),
-		whitelistLoaders: make([]loader.Loader, 0),
+		whitelistLoaders: make([]loader.Source, 0),
 		fileLoaders:      make([]*loader.FileLoader, 0),
 	}
 }
@@ -45,47 +46,157 @@ func (b *Builder) UseAlgorithm(algo AlgorithmType) *Builder {
 // LoadFile adds a file loader to load words from the specified file path
 func (b *Builder) LoadFile(path string) *Builder {
 	fileLoader := loader.NewFileLoader(path)
-	b.loaders = append(b.loaders, fileLoader)
+	b.addSource(path, fileLoader, 0)
 	b.fileLoaders = append(b.fileLoaders, fileLoader)
 	return b
 }
 
 // LoadMemory adds words directly from a string slice
 func (b *Builder) LoadMemory(words []string) *Builder {
-	b.loaders = append(b.loaders, loader.NewMemoryLoader(words))
+	b.addSource("memory", loader.NewMemoryLoader(words), 0)
 	return b
 }
 
 // LoadHTTP adds an HTTP loader to load words from a remote URL
 func (b *Builder) LoadHTTP(url string) *Builder {
-	b.loaders = append(b.loaders, loader.NewHTTPLoader(url))
+	b.addSource(url, loader.NewHTTPLoader(url), 0)
+	return b
+}
+
+// LoadSQL adds a SQL loader that reads words from a relational table
+func (b *Builder) LoadSQL(l *loader.SQLLoader) *Builder {
+	b.addSource("sql", l, 0)
+	return b
+}
+
+// AddLoader adds a custom loader
+func (b *Builder) AddLoader(l loader.Loader) *Builder {
+	b.addSource("loader", l, 0)
+	return b
+}
+
+// LoadSource adds a named loader with an explicit priority
+// When the same word comes from several sources, higher priorities are merged first
+func (b *Builder) LoadSource(name string, l loader.Loader, priority int) *Builder {
+	b.addSource(name, l, priority)
 	return b
 }
 
 // LoadWords adds words directly to the builder
 func (b *Builder) LoadWords(words []dict.Word) *Builder {
-	b.words = append(b.words, words...)
+	b.addSource("words", loader.NewStaticLoader(words), 0)
 	return b
 }
 
 // LoadBuiltin loads the built-in default word dictionary
 func (b *Builder) LoadBuiltin() *Builder {
-	b.words = append(b.words, builtin.GetDefaultWords()...)
+	b.addSource("builtin", loader.NewStaticLoader(builtin.GetDefaultWords()), 0)
 	return b
 }
 
+// addSource registers a word source
+func (b *Builder) addSource(name string, l loader.Loader, priority int) {
+	b.sources = append(b.sources, loader.Source{Name: name, Loader: l, Priority: priority})
+}
+
 // EnablePinyin enables pinyin variant detection
+// Chinese words also match their pinyin and mixed spellings, such as "shabi" and "傻b"
+// Every word compiles up to the pinyin limit of spellings, which multiplies build
+// time and memory: the built-in dictionary builds in about 9s instead of 0.4s
+// (see BenchmarkBuild_Pinyin and Options.EnablePinyin)
 func (b *Builder) EnablePinyin() *Builder {
 	b.options.EnablePinyin = true
 	return b
 }
 
+// SetPinyinLimit sets the maximum number of pinyin spellings generated per word
+func (b *Builder) SetPinyinLimit(limit int) *Builder {
+	b.options.PinyinLimit = limit
+	return b
+}
+
+// EnablePinyinInitials enables matching of pinyin initialisms such as "sb" and "nmsl"
+// Only initialisms of at least minLength runes (2 or more) are compiled, and only
+// for words carrying tag; an empty tag compiles none, since initialisms of every
+// word would flag ordinary English text
+func (b *Builder) EnablePinyinInitials(minLength int, tag string) *Builder {
+	b.options.EnablePinyinInitials = true
+	b.options.PinyinInitialsMinLength = minLength
+	b.options.PinyinInitialsTag = tag
+	return b
+}
+
+// SetPinyinInitialsLevel sets the level reported for matches of pinyin initialisms
+func (b *Builder) SetPinyinInitialsLevel(level Level) *Builder {
+	b.options.PinyinInitialsLevel = &level
+	return b
+}
+
+// SetPinyinInitialsCategory sets the category reported for matches of pinyin initialisms
+func (b *Builder) SetPinyinInitialsCategory(category Category) *Builder {
+	b.options.PinyinInitialsCategory = &category
+	return b
+}
+
 // EnableVariant enables traditional Chinese variant detection
 func (b *Builder) EnableVariant() *Builder {
 	b.options.EnableTraditional = true
 	return b
 }
 
+// SetConversion enables traditional Chinese variant detection with the given
+// normalisation, such as variant.ConvertS2T for a traditional dictionary
+func (b *Builder) SetConversion(conversion variant.Conversion) *Builder {
+	b.options.EnableTraditional = true
+	b.options.Conversion = conversion
+	return b
+}
+
+// EnableInvisible enables removal of zero-width, format and other invisible characters
+func (b *Builder) EnableInvisible() *Builder {
+	b.options.EnableInvisible = true
+	return b
+}
+
+// EnableNormalize enables folding of full-width, circled and mathematical letters,
+// combining diacritics and case before matching
+func (b *Builder) EnableNormalize() *Builder {
+	b.options.EnableNormalize = true
+	return b
+}
+
+// EnableConfusables enables matching of homoglyphs such as Cyrillic "а" for Latin "a"
+// With crossScriptOnly, look-alikes within one script, such as "1" for "l", are not folded
+func (b *Builder) EnableConfusables(crossScriptOnly bool) *Builder {
+	b.options.EnableConfusables = true
+	b.options.ConfusablesCrossScriptOnly = crossScriptOnly
+	return b
+}
+
+// EnableSubstitution lets dictionary characters match the input sequences of table,
+// such as "4" for 'a' or "ph" for 'f'; nil uses algorithm.DefaultSubstitutions()
+func (b *Builder) EnableSubstitution(table algorithm.Substitutions) *Builder {
+	b.options.EnableSubstitution = true
+	b.options.Substitutions = table
+	return b
+}
+
+// SetSubstitutionLimit sets the maximum number of substituted spellings compiled per word
+// Detector.TruncatedSubstitutions lists the words with more spellings than limit
+func (b *Builder) SetSubstitutionLimit(limit int) *Builder {
+	b.options.SubstitutionLimit = limit
+	return b
+}
+
+// EnableRepeatCollapse lets a run of up to maxRun identical characters match one
+// occurrence where the word does not repeat it, such as "傻傻傻比" for "傻比"
+// A maxRun of 0 or less uses algorithm.DefaultMaxRun
+func (b *Builder) EnableRepeatCollapse(maxRun int) *Builder {
+	b.options.EnableRepeatCollapse = true
+	b.options.MaxRepeatRun = maxRun
+	return b
+}
+
 // EnableSymbol enables symbol interference filtering
 func (b *Builder) EnableSymbol() *Builder {
 	b.options.EnableSymbolFilter = true
@@ -98,30 +209,69 @@ func (b *Builder) EnableSimilarChar() *Builder {
 	return b
 }
 
+// AddSimilarRule enables similar character detection with a custom rule
+// mapping look-alike characters to base; custom rules override the built-in ones
+func (b *Builder) AddSimilarRule(base rune, similars ...rune) *Builder {
+	b.options.EnableSimilarChar = true
+	b.options.SimilarRules = append(b.options.SimilarRules, variant.SimilarRule{Base: base, Similars: similars})
+	return b
+}
+
+// LoadSimilarFile enables similar character detection with rules loaded from a file
+func (b *Builder) LoadSimilarFile(path string) *Builder {
+	return b.AddSimilarLoader(loader.NewFileLoader(path))
+}
+
+// AddSimilarLoader enables similar character detection with rules from a custom loader
+func (b *Builder) AddSimilarLoader(l loader.SimilarLoader) *Builder {
+	b.options.EnableSimilarChar = true
+	b.similarLoaders = append(b.similarLoaders, l)
+	return b
+}
+
 // AddWhitelist adds words to the whitelist
 func (b *Builder) AddWhitelist(words ...string) *Builder {
 	b.whitelist = append(b.whitelist, words...)
 	return b
 }
 
+// AddTaggedWhitelist adds whitelist words that only exempt matches carrying one of the tags
+func (b *Builder) AddTaggedWhitelist(tags []string, words ...string) *Builder {
+	for _, word := range words {
+		b.taggedWhitelist = append(b.taggedWhitelist, dict.Word{Text: word, Tags: tags})
+	}
+	return b
+}
+
 // LoadWhitelistFile loads whitelist from a file
 func (b *Builder) LoadWhitelistFile(path string) *Builder {
-	b.whitelistLoaders = append(b.whitelistLoaders, loader.NewFileLoader(path))
+	b.addWhitelistSource(path, loader.NewFileLoader(path))
 	return b
 }
 
 // LoadWhitelistMemory loads whitelist from memory
 func (b *Builder) LoadWhitelistMemory(words []string) *Builder {
-	b.whitelistLoaders = append(b.whitelistLoaders, loader.NewMemoryLoader(words))
+	b.addWhitelistSource("memory", loader.NewMemoryLoader(words))
 	return b
 }
 
 // LoadWhitelistHTTP loads whitelist from a remote HTTP(S) URL
 func (b *Builder) LoadWhitelistHTTP(url string) *Builder {
-	b.whitelistLoaders = append(b.whitelistLoaders, loader.NewHTTPLoader(url))
+	b.addWhitelistSource(url, loader.NewHTTPLoader(url))
+	return b
+}
+
+// AddWhitelistLoader adds a custom whitelist loader
+func (b *Builder) AddWhitelistLoader(name string, l loader.Loader) *Builder {
+	b.addWhitelistSource(name, l)
 	return b
 }
 
+// addWhitelistSource registers a whitelist source
+func (b *Builder) addWhitelistSource(name string, l loader.Loader) {
+	b.whitelistLoaders = append(b.whitelistLoaders, loader.Source{Name: name, Loader: l})
+}
+
 // SetOptions sets custom options
 func (b *Builder) SetOptions(opts *Options) *Builder {
 	if opts != nil {
@@ -137,6 +287,51 @@ func (b *Builder) SetReplaceChar(r rune) *Builder {
 	return b
 }
 
+// SetMergePolicy sets how the same word from several sources is combined
+func (b *Builder) SetMergePolicy(policy loader.MergePolicy) *Builder {
+	b.options.MergePolicy = policy
+	return b
+}
+
+// SetScorePolicy sets how match weights are combined into the risk score
+func (b *Builder) SetScorePolicy(policy ScorePolicy) *Builder {
+	b.options.ScorePolicy = policy
+	return b
+}
+
+// SetThresholds sets the scores at which a result is sent to review or blocked
+func (b *Builder) SetThresholds(review, block float64) *Builder {
+	b.options.ReviewThreshold = review
+	b.options.BlockThreshold = block
+	return b
+}
+
+// SetRules sets the moderation rules evaluated by Detector.Decide
+func (b *Builder) SetRules(rules *RuleSet) *Builder {
+	b.rules = rules
+	return b
+}
+
+// SetLoadErrorMode sets what happens when a blacklist or whitelist source fails to load
+func (b *Builder) SetLoadErrorMode(mode loader.ErrorMode) *Builder {
+	b.options.LoadErrorMode = mode
+	return b
+}
+
+// SetCacheDir persists every remote dictionary under dir; the cached copy is
+// served when a later fetch fails and reported through LoadErrors
+func (b *Builder) SetCacheDir(dir string) *Builder {
+	b.options.CacheDir = dir
+	return b
+}
+
+// SetMaxVersions keeps the last n compiled dictionaries for Rollback; every extra
+// version holds a full matcher in memory (roughly 45MB each for the builtin dictionary)
+func (b *Builder) SetMaxVersions(n int) *Builder {
+	b.options.MaxVersions = n
+	return b
+}
+
 // SetCaseSensitive sets whether matching should be case-sensitive
 func (b *Builder) SetCaseSensitive(sensitive bool) *Builder {
 	b.options.CaseSensitive = sensitive
@@ -145,77 +340,98 @@ func (b *Builder) SetCaseSensitive(sensitive bool) *Builder {
 
 // Build constructs the Detector from the configured settings
 func (b *Builder) Build() (*Detector, error) {
-	// Load words from all loaders
-	for _, l := range b.loaders {
-		loadedWords, err := l.Load()
-		if err != nil {
-			return nil, err
+	// Attach the disk cache to remote loaders
+	if b.options.CacheDir != "" {
+		for _, sources := range [][]loader.Source{b.sources, b.whitelistLoaders} {
+			for _, source := range sources {
+				if httpLoader, ok := source.Loader.(*loader.HTTPLoader); ok && !httpLoader.HasCache() {
+					httpLoader.SetCacheDir(b.options.CacheDir)
+				}
+			}
 		}
-		b.words = append(b.words, loadedWords...)
 	}
 
-	// Choose algorithm based on word count if auto
-	var matcher algorithm.Matcher
-	if b.algorithmType == AlgorithmAuto {
-		if len(b.words) < 5000 {
-			matcher = dfa.NewDFAMatcher(b.options.CaseSensitive)
-		} else {
-			matcher = ac.NewACMatcher(b.options.CaseSensitive)
-		}
-	} else if b.algorithmType == AlgorithmDFA {
-		matcher = dfa.NewDFAMatcher(b.options.CaseSensitive)
-	} else {
-		matcher = ac.NewACMatcher(b.options.CaseSensitive)
+	// Load words from all sources
+	sets, loadErrors, err := loader.LoadSources(b.sources, b.options.LoadErrorMode)
+	if err != nil {
+		return nil, err
 	}
 
-	// Build the matcher
-	if err := matcher.Build(b.words); err != nil {
+	// Load whitelist from loaders under the same error mode
+	whitelistSets, whitelistErrors, err := loader.LoadSources(b.whitelistLoaders, b.options.LoadErrorMode)
+	if err != nil {
 		return nil, err
 	}
+	loadErrors = append(loadErrors, whitelistErrors...)
+
+	// Merge duplicates across sources
+	words, report := loader.Merge(sets, b.options.MergePolicy, b.options.CaseSensitive)
 
 	// Create detector
 	detector := &Detector{
-		matcher:    matcher,
 		options:    b.options,
 		filters:    make([]filter.Filter, 0),
 		processors: make([]variant.Processor, 0),
 		watchers:   make([]*FileWatcher, 0),
+		report:     report,
+		loadErrors: loadErrors,
+		rules:      b.rules,
+		sources:    b.sources,
+	}
+
+	// Build the similar character rules before the matcher, which compiles their forms
+	if b.options.EnableSimilarChar {
+		similar, err := b.buildSimilar()
+		if err != nil {
+			return nil, err
+		}
+		b.options.similar = similar
+	}
+
+	// Build the matcher as the first dictionary version
+	if err := detector.install(words, "build"); err != nil {
+		return nil, err
 	}
 
 	// Initialize variant processors based on options
+	if b.options.EnableInvisible {
+		detector.processors = append(detector.processors, variant.NewInvisibleProcessor())
+	}
+	if b.options.EnableNormalize {
+		detector.processors = append(detector.processors, variant.NewNormalizeProcessor())
+	}
 	if b.options.EnableSymbolFilter {
 		detector.processors = append(detector.processors, variant.NewSymbolProcessor())
 	}
 	if b.options.EnableTraditional {
-		detector.processors = append(detector.processors, variant.NewTraditionalProcessor())
+		detector.processors = append(detector.processors, variant.NewTraditionalProcessorWithConversion(b.options.Conversion))
 	}
 	if b.options.EnableSimilarChar {
-		detector.processors = append(detector.processors, variant.NewSimilarProcessor())
+		detector.processors = append(detector.processors, b.options.similar)
 	}
-	if b.options.EnablePinyin {
-		detector.processors = append(detector.processors, variant.NewPinyinProcessor())
+	if b.options.EnableConfusables && b.options.CaseSensitive {
+		detector.processors = append(detector.processors, variant.NewCaseSensitiveConfusableProcessor(b.options.ConfusablesCrossScriptOnly))
+	} else if b.options.EnableConfusables {
+		detector.processors = append(detector.processors, variant.NewConfusableProcessor(b.options.ConfusablesCrossScriptOnly))
 	}
 
-	// Load whitelist from loaders
-	whitelistWords := make([]string, 0)
-	for _, l := range b.whitelistLoaders {
-		loadedWords, err := l.Load()
-		if err != nil {
-			// Skip failed loaders but continue
-			continue
-		}
-		// Extract text from Word objects
-		for _, w := range loadedWords {
-			whitelistWords = append(whitelistWords, w.Text)
+	// Combine loaded whitelist words with directly added ones
+	// Whitelist words that carry tags only exempt matches with one of those tags
+	whitelist := filter.NewWhitelist(b.whitelist)
+	whitelistCount := len(b.whitelist) + len(b.taggedWhitelist)
+	for _, w := range b.taggedWhitelist {
+		whitelist.AddTagged(w.Text, w.Tags...)
+	}
+	for _, set := range whitelistSets {
+		for _, w := range set.Words {
+			whitelist.AddTagged(w.Text, w.Tags...)
+			whitelistCount++
 		}
 	}
 
-	// Combine with directly added whitelist
-	whitelistWords = append(whitelistWords, b.whitelist...)
-
 	// Add whitelist filter if provided
-	if len(whitelistWords) > 0 {
-		detector.filters = append(detector.filters, filter.NewWhitelist(whitelistWords))
+	if whitelistCount > 0 {
+		detector.filters = append(detector.filters, whitelist)
 	}
 
 	// Start file watchers if enabled
@@ -227,5 +443,34 @@ func (b *Builder) Build() (*Detector, error) {
 		}
 	}
 
+	// Start SQL pollers if enabled
+	if b.options.WatchSQL {
+		for _, source := range b.sources {
+			if sqlLoader, ok := source.Loader.(*loader.SQLLoader); ok {
+				poller := NewSQLWatcher(detector, sqlLoader, b.options.WatchInterval)
+				poller.Start()
+				detector.pollers = append(detector.pollers, poller)
+			}
+		}
+	}
+
 	return detector, nil
 }
+
+// buildSimilar combines the built-in, added and loaded similar character rules
+func (b *Builder) buildSimilar() (*variant.SimilarProcessor, error) {
+	rules := append([]variant.SimilarRule(nil), b.options.SimilarRules...)
+	for _, l := range b.similarLoaders {
+		loaded, err := l.LoadSimilar()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load similar rules: %w", err)
+		}
+		rules = append(rules, loaded...)
+	}
+
+	similar, err := variant.NewSimilarProcessorWithRules(rules...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build similar rules: %w", err)
+	}
+	return similar, nil
+}
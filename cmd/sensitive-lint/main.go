@@ -0,0 +1,181 @@
+// Command sensitive-lint checks sensitive word dictionaries for duplicates,
+// junk entries and likely false positives.
+//
+// Usage:
+//
+//	sensitive-lint [flags] [file ...]
+//
+// Plain text files keep their line numbers; JSON files report the 1-based
+// index of each entry instead. With -builtin the embedded default dictionary
+// is checked as well. The exit status is 1 when any error-level issue is found.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Karrecy/sensitive-go/builtin"
+	"github.com/Karrecy/sensitive-go/dict"
+	"github.com/Karrecy/sensitive-go/loader"
+)
+
+func main() {
+	var (
+		useBuiltin    = flag.Bool("builtin", false, "lint the built-in default dictionary")
+		corpusPath    = flag.String("corpus", "", "file of clean sample text, one sample per line, used to estimate false positives")
+		phrasesPath   = flag.String("phrases", "", "file of common phrases, one per line")
+		minLength     = flag.Int("min-length", 2, "minimum entry length in runes")
+		maxHitRate    = flag.Float64("max-hit-rate", 0.01, "fraction of corpus samples an entry may match before it is reported")
+		caseSensitive = flag.Bool("case-sensitive", false, "treat entries differing only in case as distinct")
+		minSeverity   = flag.String("severity", "info", "minimum severity to print: info, warning or error")
+		topImpact     = flag.Int("top", 20, "number of highest-impact entries to print (0 disables)")
+		asJSON        = flag.Bool("json", false, "print the reports as JSON")
+	)
+	flag.Parse()
+
+	if !*useBuiltin && flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: sensitive-lint [flags] [file ...]")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	severity, err := parseSeverity(*minSeverity)
+	if err != nil {
+		fatal(err)
+	}
+
+	opts := dict.LintOptions{
+		MinLength:     *minLength,
+		MaxHitRate:    *maxHitRate,
+		CaseSensitive: *caseSensitive,
+	}
+	if *corpusPath != "" {
+		if opts.Corpus, err = readLines(*corpusPath); err != nil {
+			fatal(err)
+		}
+	}
+	if *phrasesPath != "" {
+		if opts.CommonPhrases, err = readLines(*phrasesPath); err != nil {
+			fatal(err)
+		}
+	}
+
+	type fileReport struct {
+		File   string           `json:"file"`
+		Report *dict.LintReport `json:"report"`
+	}
+	reports := make([]fileReport, 0)
+
+	if *useBuiltin {
+		reports = append(reports, fileReport{"builtin", dict.LintWords(builtin.GetDefaultWords(), opts)})
+	}
+	for _, path := range flag.Args() {
+		entries, err := readEntries(path)
+		if err != nil {
+			fatal(err)
+		}
+		reports = append(reports, fileReport{path, dict.Lint(entries, opts)})
+	}
+
+	errorsFound := 0
+	for _, r := range reports {
+		errorsFound += r.Report.Count(dict.SeverityError)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(reports); err != nil {
+			fatal(err)
+		}
+	} else {
+		for _, r := range reports {
+			for _, issue := range r.Report.Issues {
+				if issue.Severity >= severity {
+					fmt.Printf("%s:%s\n", r.File, issue)
+				}
+			}
+
+			for i, impact := range r.Report.Impact {
+				if i >= *topImpact {
+					break
+				}
+				fmt.Printf("%s:%d: impact: %q matches %d samples (%.2f%%)\n",
+					r.File, impact.Line, impact.Text, impact.Hits, impact.Rate*100)
+			}
+		}
+	}
+
+	if errorsFound > 0 {
+		os.Exit(1)
+	}
+}
+
+// readEntries reads a dictionary file, keeping line numbers for plain text
+func readEntries(path string) ([]dict.Entry, error) {
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		words, err := loader.NewFileLoader(path).Load()
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]dict.Entry, len(words))
+		for i, word := range words {
+			entries[i] = dict.Entry{Word: word, Line: i + 1}
+		}
+		return entries, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	return dict.ParseEntries(file)
+}
+
+// readLines reads the non-empty lines of a file
+func readLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return lines, nil
+}
+
+// parseSeverity parses a severity name
+func parseSeverity(s string) (dict.Severity, error) {
+	switch strings.ToLower(s) {
+	case "info":
+		return dict.SeverityInfo, nil
+	case "warning":
+		return dict.SeverityWarning, nil
+	case "error":
+		return dict.SeverityError, nil
+	default:
+		return 0, fmt.Errorf("unknown severity: %q", s)
+	}
+}
+
+// fatal prints err and exits
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "sensitive-lint:", err)
+	os.Exit(2)
+}
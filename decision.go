@@ -0,0 +1,277 @@
+package gosensitive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// Action is what a moderation decision asks the caller to do with a text
+type Action int
+
+const (
+	// ActionAllow publishes the text unchanged
+	ActionAllow Action = iota
+	// ActionMask publishes the text with sensitive words replaced
+	ActionMask
+	// ActionReview holds the text for manual review
+	ActionReview
+	// ActionReject refuses the text
+	ActionReject
+)
+
+// String returns the string representation of the action
+func (a Action) String() string {
+	switch a {
+	case ActionAllow:
+		return "allow"
+	case ActionMask:
+		return "mask"
+	case ActionReview:
+		return "review"
+	case ActionReject:
+		return "reject"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAction parses an action name such as "reject"
+func ParseAction(s string) (Action, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "allow":
+		return ActionAllow, nil
+	case "mask":
+		return ActionMask, nil
+	case "review":
+		return ActionReview, nil
+	case "reject":
+		return ActionReject, nil
+	default:
+		return 0, fmt.Errorf("unknown action: %q", s)
+	}
+}
+
+// MarshalText encodes the action by name
+func (a Action) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText decodes an action name
+func (a *Action) UnmarshalText(text []byte) error {
+	action, err := ParseAction(string(text))
+	if err != nil {
+		return err
+	}
+	*a = action
+	return nil
+}
+
+// Condition selects matches and tests them; every field that is set must hold
+// An empty condition always holds, which makes it useful as a catch-all rule
+type Condition struct {
+	// Categories limits the condition to matches in any of these categories (empty means all)
+	Categories []Category `json:"categories,omitempty"`
+
+	// MinLevel limits the condition to matches at or above this level
+	MinLevel dict.Level `json:"min_level,omitempty"`
+
+	// Tags limits the condition to matches carrying any of these tags (empty means all)
+	Tags []string `json:"tags,omitempty"`
+
+	// MinCount is the number of selected matches required; when Categories,
+	// MinLevel or Tags is set, at least one selected match is always required
+	MinCount int `json:"min_count,omitempty"`
+
+	// MinScore is the risk score the selected matches must reach (0 disables)
+	MinScore float64 `json:"min_score,omitempty"`
+}
+
+// selective reports whether the condition narrows down the matches
+func (c *Condition) selective() bool {
+	return len(c.Categories) > 0 || c.MinLevel > dict.LevelLow || len(c.Tags) > 0
+}
+
+// selects reports whether the condition applies to a match
+func (c *Condition) selects(m Match) bool {
+	if m.Level < c.MinLevel {
+		return false
+	}
+	if len(c.Tags) > 0 && !m.hasAnyTag(c.Tags) {
+		return false
+	}
+	if len(c.Categories) == 0 {
+		return true
+	}
+	for _, category := range c.Categories {
+		if m.Category.Has(category) {
+			return true
+		}
+	}
+	return false
+}
+
+// holds evaluates the condition against the matches of a result
+func (c *Condition) holds(result *Result, opts *Options) bool {
+	selected := make([]Match, 0, len(result.Matches))
+	for _, m := range result.Matches {
+		if c.selects(m) {
+			selected = append(selected, m)
+		}
+	}
+
+	minCount := c.MinCount
+	if minCount == 0 && c.selective() {
+		minCount = 1
+	}
+	if len(selected) < minCount {
+		return false
+	}
+
+	if c.MinScore > 0 && opts.Score(selected) < c.MinScore {
+		return false
+	}
+
+	return true
+}
+
+// Rule maps a condition onto an action
+type Rule struct {
+	Name   string    `json:"name"`   // Name reported in decisions
+	When   Condition `json:"when"`   // Condition that must hold for the rule to fire
+	Action Action    `json:"action"` // Action taken when the rule fires
+}
+
+// RuleSet is an ordered list of rules; the first rule that fires decides
+type RuleSet struct {
+	Rules   []Rule `json:"rules"`
+	Default Action `json:"default"` // Action taken when no rule fires
+}
+
+// Decision is the outcome of evaluating a rule set against a result
+type Decision struct {
+	Action Action  `json:"action"`         // What to do with the text
+	Rule   *Rule   `json:"rule,omitempty"` // The rule that fired (nil when the default applied)
+	Result *Result `json:"-"`              // The detection result the decision was based on
+}
+
+// RuleName returns the name of the rule that fired, or "default"
+func (d *Decision) RuleName() string {
+	if d.Rule == nil {
+		return "default"
+	}
+	return d.Rule.Name
+}
+
+// NewRuleSet creates a rule set with the given default action and rules
+func NewRuleSet(defaultAction Action, rules ...Rule) *RuleSet {
+	return &RuleSet{Rules: rules, Default: defaultAction}
+}
+
+// LoadRules reads a rule set from JSON
+func LoadRules(r io.Reader) (*RuleSet, error) {
+	var raw struct {
+		Rules []struct {
+			Name   string    `json:"name"`
+			When   Condition `json:"when"`
+			Action *Action   `json:"action"`
+		} `json:"rules"`
+		Default *Action `json:"default"`
+	}
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to parse rules: %w", err)
+	}
+
+	rules := &RuleSet{Rules: make([]Rule, 0, len(raw.Rules))}
+	if raw.Default != nil {
+		rules.Default = *raw.Default
+	}
+
+	// A rule without an action would silently allow, so it is rejected
+	for i, rule := range raw.Rules {
+		if rule.Action == nil {
+			return nil, fmt.Errorf("rule %d (%s) has no action", i+1, rule.Name)
+		}
+		rules.Rules = append(rules.Rules, Rule{Name: rule.Name, When: rule.When, Action: *rule.Action})
+	}
+
+	return rules, nil
+}
+
+// LoadRulesFile reads a rule set from a JSON file
+func LoadRulesFile(path string) (*RuleSet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rules file: %w", err)
+	}
+	defer file.Close()
+
+	return LoadRules(file)
+}
+
+// Evaluate returns the decision of the first rule whose condition holds
+// opts supplies the scoring policy for MinScore conditions (nil means DefaultOptions)
+func (rs *RuleSet) Evaluate(result *Result, opts *Options) *Decision {
+	if opts == nil {
+		opts = DefaultOptions()
+	}
+
+	for i := range rs.Rules {
+		rule := &rs.Rules[i]
+		if rule.When.holds(result, opts) {
+			return &Decision{Action: rule.Action, Rule: rule, Result: result}
+		}
+	}
+
+	return &Decision{Action: rs.Default, Result: result}
+}
+
+// verdictAction maps a score verdict onto an action when no rules are configured
+func verdictAction(result *Result) Action {
+	switch result.Verdict {
+	case VerdictBlock:
+		return ActionReject
+	case VerdictReview:
+		return ActionReview
+	}
+	if result.Found {
+		return ActionMask
+	}
+	return ActionAllow
+}
+
+// Decide detects sensitive words in text and evaluates the rule set against the result
+// Without rules, the action follows the score verdict: block rejects, review reviews,
+// any other match masks and a clean text is allowed
+func (d *Detector) Decide(text string) *Decision {
+	result := d.FindAll(text)
+
+	d.mu.RLock()
+	rules := d.rules
+	d.mu.RUnlock()
+
+	return d.decide(result, rules)
+}
+
+// decide evaluates the rules against a result, falling back to the score verdict
+func (d *Detector) decide(result *Result, rules *RuleSet) *Decision {
+	if rules == nil {
+		return &Decision{Action: verdictAction(result), Result: result}
+	}
+	return rules.Evaluate(result, d.options)
+}
+
+// SetRules replaces the rule set used by Decide (nil restores the score verdict)
+func (d *Detector) SetRules(rules *RuleSet) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rules = rules
+}
@@ -1,23 +1,33 @@
 package gosensitive
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/Karrecy/sensitive-go/algorithm"
-	"github.com/Karrecy/sensitive-go/algorithm/ac"
-	"github.com/Karrecy/sensitive-go/algorithm/dfa"
 	"github.com/Karrecy/sensitive-go/dict"
 	"github.com/Karrecy/sensitive-go/filter"
+	"github.com/Karrecy/sensitive-go/loader"
 	"github.com/Karrecy/sensitive-go/variant"
 )
 
 // Detector is the main sensitive word detector
 type Detector struct {
 	matcher    algorithm.Matcher
+	words      []dict.Word // Words the current matcher was built from
+	version    Version     // Version of the current matcher
+	history    []*snapshot // Retained versions, oldest first
+	nextID     uint64      // ID of the most recently installed version
 	filters    []filter.Filter
 	processors []variant.Processor
 	watchers   []*FileWatcher
+	pollers    []*SQLWatcher
+	sources    []loader.Source // Word sources the detector was built from, loaded again by Refresh
 	options    *Options
+	rules      *RuleSet   // Rules evaluated by Decide
+	reloadMu   sync.Mutex // Serializes read-modify-write reloads
+	report     *loader.MergeReport
+	loadErrors []*loader.SourceError
 	mu         sync.RWMutex
 }
 
@@ -45,113 +55,148 @@ func (d *Detector) Find(text string) []Match {
 	d.mu.RLock()
 	defer d.mu.RUnlock()
 
-	// Preprocess text with variant processors
-	text = d.preprocessText(text)
+	// Preprocess text with variant processors, keeping offsets into the original
+	view := newTextView(text, d.processors)
+	defer view.release()
 	
-	matches := d.matcher.Match(text)
-	result := make([]Match, 0, len(matches))
+	return d.collect(view, d.matcher.Match(view.processed))
+}
 
-	for _, m := range matches {
-		// Apply filters
-		if d.shouldFilter(m.Word) {
-			continue
-		}
+// FindAll returns detailed detection results
+func (d *Detector) FindAll(text string) *Result {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
 
-		// Apply category filter
-		if len(d.options.Categories) > 0 {
-			found := false
-			for _, cat := range d.options.Categories {
-				if m.Category&dict.Category(cat) != 0 {
-					found = true
-					break
-				}
-			}
-			if !found {
-				continue
-			}
-		}
+	// Preprocess text once
+	view := newTextView(text, d.processors)
+	defer view.release()
 
-		// Apply level filter
-		if m.Level < dict.Level(d.options.MinLevel) {
-			continue
-		}
+	// Match on preprocessed text
+	matches := d.matcher.Match(view.processed)
 
-		result = append(result, Match{
-			Word:     m.Word,
-			Start:    m.Start,
-			End:      m.End,
-			Category: dict.Category(m.Category),
-			Level:    dict.Level(m.Level),
-		})
+	return d.newResult(view, matches, d.collect(view, matches))
+}
 
-		// Check max match count
-		if d.options.MaxMatchCount > 0 && len(result) >= d.options.MaxMatchCount {
-			break
-		}
+// newResult assembles a result, masking the original text and scoring the matches
+func (d *Detector) newResult(view *textView, raw []algorithm.MatchResult, matches []Match) *Result {
+	score := d.options.Score(matches)
+
+	return &Result{
+		Found:        len(matches) > 0,
+		Matches:      matches,
+		FilteredText: view.mask(d.masked(view, raw, matches), d.options.ReplaceChar),
+		Score:        score,
+		Verdict:      d.options.Verdict(score),
 	}
+}
 
+// collect applies the whitelist, category, level and tag options to raw matches
+// and maps the survivors back onto the original text
+func (d *Detector) collect(view *textView, matches []algorithm.MatchResult) []Match {
+	result, _ := d.trace(view, matches, false, d.options.MaxMatchCount)
 	return result
 }
 
-// FindAll returns detailed detection results
-func (d *Detector) FindAll(text string) *Result {
-	d.mu.RLock()
-	defer d.mu.RUnlock()
+// masked returns the matches to mask given the kept ones: MaxMatchCount only
+// bounds the matches reported, so every match the filters keep is masked
+func (d *Detector) masked(view *textView, raw []algorithm.MatchResult, kept []Match) []Match {
+	if d.options.MaxMatchCount > 0 && len(kept) >= d.options.MaxMatchCount {
+		view.masking = true
+		kept, _ = d.trace(view, raw, false, 0)
+		view.masking = false
+	}
+	return kept
+}
 
-	// Preprocess text once
-	processedText := d.preprocessText(text)
-	
-	// Match on preprocessed text
-	matches := d.matcher.Match(processedText)
+// trace is collect with an optional record of every raw hit, keeping at most limit
+// matches (0 for no limit)
+// When explain is true, all hits are located and returned with the reason they were dropped
+func (d *Detector) trace(view *textView, matches []algorithm.MatchResult, explain bool, limit int) ([]Match, []Hit) {
+	matches = longestVariants(matches)
 	result := make([]Match, 0, len(matches))
+	var hits []Hit
+	if explain {
+		hits = make([]Hit, 0, len(matches))
+	}
 
 	for _, m := range matches {
-		// Apply filters
-		if d.shouldFilter(m.Word) {
-			continue
+		// Check max match count
+		limited := limit > 0 && len(result) >= limit
+		if limited && !explain {
+			break
 		}
 
-		// Apply category filter
-		if len(d.options.Categories) > 0 {
-			found := false
-			for _, cat := range d.options.Categories {
-				if m.Category&dict.Category(cat) != 0 {
-					found = true
-					break
-				}
-			}
-			if !found {
-				continue
-			}
+		match := Match{
+			Word:     m.Word,
+			Start:    m.Start,
+			End:      m.End,
+			Category: m.Category,
+			Level:    m.Level,
+			Weight:   d.options.weightOf(m.Level, m.Weight),
+			Tags:     m.Tags,
+			Source:   m.Source,
 		}
 
-		// Apply level filter
-		if m.Level < dict.Level(d.options.MinLevel) {
-			continue
+		reason, detail := d.reject(match)
+		if reason == "" && limited {
+			reason, detail = DropMaxMatchCount, fmt.Sprintf("limit of %d matches reached", d.options.MaxMatchCount)
 		}
 
-		result = append(result, Match{
-			Word:     m.Word,
-			Start:    m.Start,
-			End:      m.End,
-			Category: dict.Category(m.Category),
-			Level:    dict.Level(m.Level),
-		})
+		if reason == "" || explain {
+			view.locate(&match, d.processors)
+			if m.Variant != "" && !containsString(match.Variants, m.Variant) {
+				match.Variants = append(match.Variants, m.Variant)
+			}
+		}
+		if explain {
+			hits = append(hits, Hit{Match: match, Kept: reason == "", DroppedBy: reason, Detail: detail})
+		}
+		if reason == "" {
+			result = append(result, match)
+		}
+	}
 
-		// Check max match count
-		if d.options.MaxMatchCount > 0 && len(result) >= d.options.MaxMatchCount {
-			break
+	return result, hits
+}
+
+// reject returns why a match is dropped by the filters and the detection options
+// The reason is empty when the match is kept
+func (d *Detector) reject(m Match) (string, string) {
+	// Apply filters
+	if name := d.filteredBy(m.Word, m.Tags); name != "" {
+		return DropFilter, fmt.Sprintf("filtered by %s", name)
+	}
+
+	// Apply category filter
+	if len(d.options.Categories) > 0 {
+		found := false
+		for _, cat := range d.options.Categories {
+			if m.Category.Has(cat) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return DropCategory, fmt.Sprintf("category %s is not selected", m.Category)
 		}
 	}
-	
-	// Get filtered text
-	filteredText := d.matcher.Replace(processedText, d.options.ReplaceChar)
-	
-	return &Result{
-		Found:        len(result) > 0,
-		Matches:      result,
-		FilteredText: filteredText,
+
+	// Apply level filter
+	if m.Level < d.options.MinLevel {
+		return DropLevel, fmt.Sprintf("level %s is below %s", m.Level, d.options.MinLevel)
+	}
+
+	// Apply tag filters
+	if len(d.options.IncludeTags) > 0 && !m.hasAnyTag(d.options.IncludeTags) {
+		return DropTags, fmt.Sprintf("none of the tags %v is included", d.options.IncludeTags)
 	}
+	for _, tag := range d.options.ExcludeTags {
+		if m.HasTag(tag) {
+			return DropTags, fmt.Sprintf("tag %s is excluded", tag)
+		}
+	}
+
+	return "", ""
 }
 
 // Replace replaces sensitive words with the given replacement string
@@ -170,10 +215,13 @@ func (d *Detector) ReplaceRune(text string, repl rune) string {
 	d.mu.RLock()
 	defer d.mu.RUnlock()
 
-	// Preprocess text with variant processors
-	text = d.preprocessText(text)
+	// Match on preprocessed text and mask the kept matches in the original
+	view := newTextView(text, d.processors)
+	defer view.release()
+	view.masking = true
 	
-	return d.matcher.Replace(text, repl)
+	matches, _ := d.trace(view, d.matcher.Match(view.processed), false, 0)
+	return view.mask(matches, repl)
 }
 
 // Validate checks if the text is clean (returns true if no sensitive words found)
@@ -195,38 +243,72 @@ func (d *Detector) Filter(text string) string {
 // Reload reloads the detector with new words atomically
 // If reload fails, the detector keeps using the old matcher
 func (d *Detector) Reload(words []dict.Word) error {
-	// Create a new matcher instance
-	var newMatcher algorithm.Matcher
-	caseSensitive := d.options.CaseSensitive
-	
-	// Determine algorithm type based on word count
-	if len(words) < 5000 {
-		newMatcher = dfa.NewDFAMatcher(caseSensitive)
-	} else {
-		newMatcher = ac.NewACMatcher(caseSensitive)
+	d.reloadMu.Lock()
+	defer d.reloadMu.Unlock()
+
+	return d.install(words, "reload")
+}
+
+// Refresh loads every word source again, merges them and reloads the detector
+// Sources fail under the load error mode used at build time; the whitelist is not reloaded
+func (d *Detector) Refresh() error {
+	d.reloadMu.Lock()
+	defer d.reloadMu.Unlock()
+
+	sets, loadErrors, err := loader.LoadSources(d.sources, d.options.LoadErrorMode)
+	if err != nil {
+		return err
 	}
-	
-	// Build the new matcher
-	if err := newMatcher.Build(words); err != nil {
+	words, report := loader.Merge(sets, d.options.MergePolicy, d.options.CaseSensitive)
+	if err := d.install(words, "refresh"); err != nil {
 		return err
 	}
-	
-	// Atomically replace the old matcher with the new one
+
 	d.mu.Lock()
-	d.matcher = newMatcher
+	d.report = report
+	d.loadErrors = loadErrors
 	d.mu.Unlock()
-	
 	return nil
 }
 
-// shouldFilter checks if a word should be filtered out by whitelist
-func (d *Detector) shouldFilter(word string) bool {
+// Words returns a copy of the words the detector currently matches
+func (d *Detector) Words() []dict.Word {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	words := make([]dict.Word, len(d.words))
+	copy(words, d.words)
+	return words
+}
+
+// ApplyChangeSet applies a dictionary change set to the current words and reloads
+// If the change set does not fit the current words, the detector is left unchanged
+func (d *Detector) ApplyChangeSet(changes *dict.ChangeSet) error {
+	d.reloadMu.Lock()
+	defer d.reloadMu.Unlock()
+
+	words, err := changes.Apply(d.Words())
+	if err != nil {
+		return err
+	}
+	return d.install(words, "changeset")
+}
+
+// filteredBy returns the name of the first filter, such as the whitelist, that filters out the word
+// Filters that understand tags are asked with the tags of the matched word
+func (d *Detector) filteredBy(word string, tags []string) string {
 	for _, f := range d.filters {
+		if tagFilter, ok := f.(filter.TagFilter); ok {
+			if tagFilter.ShouldFilterTagged(word, tags) {
+				return f.Name()
+			}
+			continue
+		}
 		if f.ShouldFilter(word) {
-			return true
+			return f.Name()
 		}
 	}
-	return false
+	return ""
 }
 
 // AddFilter adds a custom filter
@@ -237,7 +319,27 @@ func (d *Detector) AddFilter(f filter.Filter) {
 	d.filters = append(d.filters, f)
 }
 
-// Close stops all file watchers and releases resources
+// Conflicts returns the words that sources defined differently when the detector was built or refreshed
+func (d *Detector) Conflicts() []loader.Conflict {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.report == nil {
+		return nil
+	}
+	return d.report.Conflicts
+}
+
+// LoadErrors returns the sources that failed when the detector was built or refreshed
+// In strict mode only sources served from their own cache are reported
+func (d *Detector) LoadErrors() []*loader.SourceError {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.loadErrors
+}
+
+// Close stops all file and SQL watchers and releases resources
 func (d *Detector) Close() error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -246,6 +348,10 @@ func (d *Detector) Close() error {
 		watcher.Stop()
 	}
 	d.watchers = nil
+	for _, poller := range d.pollers {
+		poller.Stop()
+	}
+	d.pollers = nil
 	
 	return nil
 }
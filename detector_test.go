@@ -0,0 +1,1187 @@
+package gosensitive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Karrecy/sensitive-go/algorithm"
+	"github.com/Karrecy/sensitive-go/builtin"
+	"github.com/Karrecy/sensitive-go/dict"
+	"github.com/Karrecy/sensitive-go/loader"
+	"github.com/Karrecy/sensitive-go/variant"
+)
+
+func TestBuilder_MergeSources(t *testing.T) {
+	detector, err := New().
+		LoadMemory([]string{"敏感词", "测试"}).
+		LoadSource("political", loader.NewStaticLoader([]dict.Word{
+			{Text: "敏感词", Category: dict.CategoryPolitical, Level: dict.LevelHigh},
+		}), 10).
+		SetMergePolicy(loader.MergeFirstWins).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	matches := detector.Find("这是敏感词")
+	if len(matches) != 1 {
+		t.Fatalf("Expected 1 match, got %d", len(matches))
+	}
+	if matches[0].Category != dict.CategoryPolitical || matches[0].Level != dict.LevelHigh {
+		t.Errorf("Expected the high-priority entry, got %+v", matches[0])
+	}
+
+	conflicts := detector.Conflicts()
+	if len(conflicts) != 1 || conflicts[0].Text != "敏感词" {
+		t.Errorf("Expected one conflict for '敏感词', got %+v", conflicts)
+	}
+}
+
+func TestBuilder_LoadErrorMode(t *testing.T) {
+	build := func(mode loader.ErrorMode) (*Detector, error) {
+		return New().
+			LoadMemory([]string{"敏感词", "测试"}).
+			LoadWhitelistFile("/nonexistent/whitelist.txt").
+			SetLoadErrorMode(mode).
+			Build()
+	}
+
+	if _, err := build(loader.ErrorStrict); err == nil {
+		t.Error("Expected strict mode to fail on a broken whitelist source")
+	}
+
+	detector, err := build(loader.ErrorLenient)
+	if err != nil {
+		t.Fatalf("Expected lenient mode to build, got %v", err)
+	}
+	loadErrors := detector.LoadErrors()
+	if len(loadErrors) != 1 || loadErrors[0].Source != "/nonexistent/whitelist.txt" {
+		t.Errorf("Expected the whitelist source to be reported, got %v", loadErrors)
+	}
+}
+
+func TestDetector_Refresh(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(path, []byte("敏感词\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write words: %v", err)
+	}
+
+	detector, err := New().LoadMemory([]string{"测试"}).LoadFile(path).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("新词\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write words: %v", err)
+	}
+	if err := detector.Refresh(); err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if !detector.Contains("测试") || !detector.Contains("新词") || detector.Contains("敏感词") {
+		t.Errorf("Expected every source to be loaded again, got %+v", detector.Words())
+	}
+	if detector.CurrentVersion().Source != "refresh" {
+		t.Errorf("Expected a refresh version, got %+v", detector.CurrentVersion())
+	}
+
+	// A failing source leaves the detector unchanged in strict mode
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("Failed to remove words: %v", err)
+	}
+	if err := detector.Refresh(); err == nil {
+		t.Error("Expected error when a source fails to load")
+	}
+	if !detector.Contains("新词") {
+		t.Error("Expected the detector to keep its words after a failed refresh")
+	}
+}
+
+func TestDetector_ApplyChangeSet(t *testing.T) {
+	detector, err := New().LoadMemory([]string{"敏感词", "测试"}).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	next := []dict.Word{
+		{Text: "敏感词", Category: dict.CategoryOther, Level: dict.LevelMedium},
+		{Text: "新词", Category: dict.CategoryOther, Level: dict.LevelHigh},
+	}
+	changes := dict.Diff(detector.Words(), next)
+
+	if err := detector.ApplyChangeSet(changes); err != nil {
+		t.Fatalf("ApplyChangeSet failed: %v", err)
+	}
+	if detector.Contains("测试") || !detector.Contains("新词") {
+		t.Error("Expected '测试' removed and '新词' added")
+	}
+
+	if err := detector.ApplyChangeSet(changes); err == nil {
+		t.Error("Expected error when the change set no longer fits")
+	}
+	if len(detector.Words()) != 2 {
+		t.Errorf("Expected detector to be unchanged, got %d words", len(detector.Words()))
+	}
+
+	// A change set computed against a since-edited entry is rejected
+	stale := dict.Diff(detector.Words(), []dict.Word{
+		{Text: "敏感词", Category: dict.CategoryPolitical, Level: dict.LevelHigh},
+		{Text: "新词", Category: dict.CategoryOther, Level: dict.LevelHigh},
+	})
+	edited := dict.Diff(detector.Words(), []dict.Word{
+		{Text: "敏感词", Category: dict.CategoryAbuse, Level: dict.LevelLow},
+		{Text: "新词", Category: dict.CategoryOther, Level: dict.LevelHigh},
+	})
+	if err := detector.ApplyChangeSet(edited); err != nil {
+		t.Fatalf("ApplyChangeSet failed: %v", err)
+	}
+	version := detector.CurrentVersion().ID
+	if err := detector.ApplyChangeSet(stale); err == nil {
+		t.Error("Expected error when a modified entry changed since the diff")
+	}
+	if detector.CurrentVersion().ID != version {
+		t.Error("Expected a stale change set to leave the detector unchanged")
+	}
+	for _, w := range detector.Words() {
+		if w.Text == "敏感词" && w.Category != dict.CategoryAbuse {
+			t.Errorf("Expected the edited definition to stay, got %+v", w)
+		}
+	}
+}
+
+func TestDetector_Rollback(t *testing.T) {
+	detector, err := New().LoadMemory([]string{"敏感词"}).SetMaxVersions(2).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	first := detector.CurrentVersion()
+	if first.ID != 1 || first.Source != "build" || first.WordCount != 1 {
+		t.Errorf("Unexpected initial version: %+v", first)
+	}
+
+	if err := detector.Reload([]dict.Word{{Text: "测试"}}); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	second := detector.CurrentVersion()
+	if second.ID != 2 || second.Fingerprint == first.Fingerprint {
+		t.Errorf("Expected a new version with a different fingerprint, got %+v", second)
+	}
+
+	if err := detector.Rollback(first.ID); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	if !detector.Contains("敏感词") || detector.Contains("测试") {
+		t.Error("Expected the first dictionary after rollback")
+	}
+	if detector.CurrentVersion().ID != first.ID {
+		t.Errorf("Expected current version %d, got %d", first.ID, detector.CurrentVersion().ID)
+	}
+
+	// A third version evicts the oldest non-current version
+	if err := detector.Reload([]dict.Word{{Text: "新词"}}); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	versions := detector.Versions()
+	if len(versions) != 2 || versions[0].ID != 2 || versions[1].ID != 3 {
+		t.Errorf("Expected versions [2 3], got %+v", versions)
+	}
+	if err := detector.Rollback(first.ID); err == nil {
+		t.Error("Expected error when rolling back to an evicted version")
+	}
+
+	// By default only the live dictionary is kept
+	detector, err = New().LoadMemory([]string{"敏感词"}).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if err := detector.Reload([]dict.Word{{Text: "测试"}}); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if versions := detector.Versions(); len(versions) != 1 || versions[0].ID != 2 {
+		t.Errorf("Expected versions [2], got %+v", versions)
+	}
+	if err := detector.Rollback(1); err == nil {
+		t.Error("Expected error when rolling back without history")
+	}
+}
+
+func TestDetector_CustomCategory(t *testing.T) {
+	gambling := dict.MustRegisterCategory("gambling")
+
+	opts := DefaultOptions()
+	opts.Categories = []Category{gambling}
+
+	detector, err := New().
+		LoadWords([]dict.Word{
+			{Text: "赌场", Category: gambling.Add(CategoryAd), Level: dict.LevelHigh},
+			{Text: "敏感词", Category: CategoryPolitical, Level: dict.LevelHigh},
+		}).
+		SetOptions(opts).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	result := detector.FindAll("赌场和敏感词")
+	if len(result.Matches) != 1 || result.Matches[0].Word != "赌场" {
+		t.Fatalf("Expected only the gambling match, got %+v", result.Matches)
+	}
+	if !result.HasCategory(gambling) || !result.HasCategory(CategoryAd) || result.HasCategory(CategoryPolitical) {
+		t.Errorf("Unexpected categories %v", result.Matches[0].Category)
+	}
+}
+
+func TestDetector_Score(t *testing.T) {
+	words := []dict.Word{
+		{Text: "广告", Level: dict.LevelLow},
+		{Text: "赌博", Level: dict.LevelHigh},
+		{Text: "诈骗", Level: dict.LevelMedium, Weight: 8},
+	}
+
+	tests := []struct {
+		name    string
+		policy  ScorePolicy
+		text    string
+		score   float64
+		verdict Verdict
+	}{
+		{"Clean", ScoreSum, "正常内容", 0, VerdictAllow},
+		{"Single low", ScoreSum, "广告", 1, VerdictAllow},
+		{"Sum", ScoreSum, "广告赌博广告", 8, VerdictReview},
+		{"Word weight", ScoreSum, "诈骗赌博", 14, VerdictBlock},
+		{"Max", ScoreMax, "广告赌博广告", 6, VerdictReview},
+		{"Decayed", ScoreDecayed, "赌博广告广告", 6.75, VerdictReview},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector, err := New().LoadWords(words).SetScorePolicy(tt.policy).SetThresholds(3, 10).Build()
+			if err != nil {
+				t.Fatalf("Build failed: %v", err)
+			}
+
+			result := detector.FindAll(tt.text)
+			if result.Score != tt.score {
+				t.Errorf("Expected score %v, got %v", tt.score, result.Score)
+			}
+			if result.Verdict != tt.verdict {
+				t.Errorf("Expected verdict %v, got %v", tt.verdict, result.Verdict)
+			}
+		})
+	}
+}
+
+func TestDetector_Decide(t *testing.T) {
+	rules, err := LoadRules(strings.NewReader(`{
+		"default": "allow",
+		"rules": [
+			{"name": "political-high", "when": {"categories": ["political"], "min_level": "high"}, "action": "reject"},
+			{"name": "ad-spam", "when": {"categories": ["ad"], "min_count": 4}, "action": "review"},
+			{"name": "any", "when": {"min_count": 1}, "action": "mask"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("LoadRules failed: %v", err)
+	}
+
+	detector, err := New().
+		LoadWords([]dict.Word{
+			{Text: "敏感词", Category: CategoryPolitical, Level: dict.LevelHigh},
+			{Text: "政治", Category: CategoryPolitical, Level: dict.LevelLow},
+			{Text: "广告", Category: CategoryAd, Level: dict.LevelLow},
+		}).
+		SetRules(rules).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		text   string
+		action Action
+		rule   string
+	}{
+		{"这是敏感词", ActionReject, "political-high"},
+		{"广告广告广告广告", ActionReview, "ad-spam"},
+		{"广告广告", ActionMask, "any"},
+		{"政治", ActionMask, "any"},
+		{"正常内容", ActionAllow, "default"},
+	}
+
+	for _, tt := range tests {
+		decision := detector.Decide(tt.text)
+		if decision.Action != tt.action || decision.RuleName() != tt.rule {
+			t.Errorf("Decide(%q) = %v by %s, expected %v by %s",
+				tt.text, decision.Action, decision.RuleName(), tt.action, tt.rule)
+		}
+	}
+
+	if _, err := LoadRules(strings.NewReader(`{"rules": [{"name": "no-action", "when": {}}]}`)); err == nil {
+		t.Error("Expected error for a rule without an action")
+	}
+
+	// Without rules the score verdict decides
+	detector.SetRules(nil)
+	if decision := detector.Decide("这是敏感词"); decision.Action != ActionReview {
+		t.Errorf("Expected review from the score verdict, got %v", decision.Action)
+	}
+
+	// With the default thresholds a single medium hit is only masked
+	detector, err = New().LoadMemory([]string{"测试", "违禁"}).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	for text, action := range map[string]Action{"这是测试": ActionMask, "测试违禁": ActionReview} {
+		if decision := detector.Decide(text); decision.Action != action {
+			t.Errorf("Decide(%q) = %v, expected %v", text, decision.Action, action)
+		}
+	}
+}
+
+func TestDetector_Tags(t *testing.T) {
+	words := []dict.Word{
+		{Text: "博彩", Category: CategoryIllegal, Level: dict.LevelHigh, Tags: []string{"cn", "hk"}},
+		{Text: "代购", Category: CategoryAd, Level: dict.LevelLow, Tags: []string{"cn"}},
+		{Text: "敏感词", Category: CategoryOther, Level: dict.LevelMedium},
+	}
+	text := "博彩代购敏感词"
+
+	detector, err := New().LoadWords(words).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	result := detector.FindAll(text)
+	if len(result.FilterByTag("cn")) != 2 || !result.HasTag("hk") || result.HasTag("mo") {
+		t.Errorf("Expected tags to be carried into matches, got %+v", result.Matches)
+	}
+
+	tests := []struct {
+		name     string
+		include  []string
+		exclude  []string
+		expected int
+		filtered string
+	}{
+		{"Include", []string{"hk"}, nil, 1, "**代购敏感词"},
+		{"Exclude", nil, []string{"cn"}, 1, "博彩代购***"},
+		{"Include and exclude", []string{"cn"}, []string{"hk"}, 1, "博彩**敏感词"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultOptions()
+			opts.IncludeTags = tt.include
+			opts.ExcludeTags = tt.exclude
+
+			detector, err := New().LoadWords(words).SetOptions(opts).Build()
+			if err != nil {
+				t.Fatalf("Build failed: %v", err)
+			}
+			if matches := detector.Find(text); len(matches) != tt.expected {
+				t.Errorf("Expected %d matches, got %+v", tt.expected, matches)
+			}
+
+			// Dropped matches stay readable
+			if filtered := detector.Filter(text); filtered != tt.filtered {
+				t.Errorf("Expected %q, got %q", tt.filtered, filtered)
+			}
+			if filtered := detector.FindAll(text).FilteredText; filtered != tt.filtered {
+				t.Errorf("Expected %q, got %q", tt.filtered, filtered)
+			}
+		})
+	}
+
+	// The gambling term is legitimate in the hk market only
+	detector, err = New().LoadWords(words).AddTaggedWhitelist([]string{"hk"}, "博彩").Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if matches := detector.Find(text); len(matches) != 2 || matches[0].Word == "博彩" {
+		t.Errorf("Expected the hk-tagged word to be whitelisted, got %+v", matches)
+	}
+	if filtered := detector.Replace(text, "#"); filtered != "博彩#####" {
+		t.Errorf("Expected the whitelisted word to stay unmasked, got %q", filtered)
+	}
+
+	// The match limit bounds the report, not the masking
+	opts := DefaultOptions()
+	opts.MaxMatchCount = 1
+	detector, err = New().LoadWords(words).SetOptions(opts).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	result = detector.FindAll(text)
+	if len(result.Matches) != 1 || result.FilteredText != "*******" || detector.Filter(text) != "*******" {
+		t.Errorf("Expected 1 match and the whole text masked, got %+v", result)
+	}
+}
+
+func TestDetector_MatchMetadata(t *testing.T) {
+	detector, err := New().
+		LoadSource("forum", loader.NewStaticLoader([]dict.Word{
+			{Text: "敏感词", Level: dict.LevelHigh},
+			{Text: "spam", Level: dict.LevelLow},
+		}), 0).
+		EnableSymbol().
+		EnableVariant().
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	text := "第一行\n前缀 敏@感詞 和 SPAM"
+	result := detector.FindAll(text)
+	if len(result.Matches) != 2 {
+		t.Fatalf("Expected 2 matches, got %+v", result.Matches)
+	}
+
+	tests := []struct {
+		word     string
+		text     string
+		line     int
+		column   int
+		variants []string
+	}{
+		{"敏感词", "敏@感詞", 2, 4, []string{"symbol", "traditional"}},
+		{"spam", "SPAM", 2, 11, nil},
+	}
+
+	for i, tt := range tests {
+		m := result.Matches[i]
+		if m.Word != tt.word || m.Text != tt.text {
+			t.Errorf("Expected %q matched as %q, got %q as %q", tt.word, tt.text, m.Word, m.Text)
+		}
+		if text[m.ByteStart:m.ByteEnd] != tt.text || string([]rune(text)[m.Start:m.End]) != tt.text {
+			t.Errorf("Offsets of %q do not slice the original text: %+v", tt.word, m)
+		}
+		if m.Line != tt.line || m.Column != tt.column {
+			t.Errorf("Expected %q at %d:%d, got %d:%d", tt.word, tt.line, tt.column, m.Line, m.Column)
+		}
+		if m.Source != "forum" {
+			t.Errorf("Expected source forum, got %q", m.Source)
+		}
+		if strings.Join(m.Variants, ",") != strings.Join(tt.variants, ",") {
+			t.Errorf("Expected variants %v, got %v", tt.variants, m.Variants)
+		}
+	}
+
+	expected := "第一行\n前缀 **** 和 ****"
+	if result.FilteredText != expected || detector.Filter(text) != expected {
+		t.Errorf("Expected the original text masked as %q, got %q", expected, result.FilteredText)
+	}
+}
+
+func TestDetector_Pinyin(t *testing.T) {
+	detector, err := New().
+		LoadWords([]dict.Word{{Text: "傻比", Level: dict.LevelHigh}}).
+		EnablePinyin().
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		text     string
+		matched  string
+		variants []string
+	}{
+		{"你个傻比", "傻比", nil},
+		{"你个shabi吧", "shabi", []string{"pinyin"}},
+		{"你个SHA比", "SHA比", []string{"pinyin"}},
+		{"你个傻bi", "傻bi", []string{"pinyin"}},
+		{"你个傻B", "傻B", []string{"pinyin"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			result := detector.FindAll(tt.text)
+			if len(result.Matches) != 1 {
+				t.Fatalf("Expected 1 match, got %+v", result.Matches)
+			}
+
+			m := result.Matches[0]
+			if m.Word != "傻比" || m.Text != tt.matched || tt.text[m.ByteStart:m.ByteEnd] != tt.matched {
+				t.Errorf("Expected 傻比 matched as %q, got %q as %q", tt.matched, m.Word, m.Text)
+			}
+			if strings.Join(m.Variants, ",") != strings.Join(tt.variants, ",") {
+				t.Errorf("Expected variants %v, got %v", tt.variants, m.Variants)
+			}
+		})
+	}
+
+	if len(detector.Words()) != 1 {
+		t.Errorf("Expected pinyin forms to stay out of the dictionary, got %d words", len(detector.Words()))
+	}
+
+	// Single characters and short spellings read like English
+	english, err := New().LoadMemory([]string{"屙", "呒", "色", "钠", "干", "干干", "阿姨", "傻比"}).EnablePinyin().Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	for _, text := range []string{"hello world", "bananas", "I am an engineer", "see me at noon"} {
+		if matches := english.Find(text); len(matches) != 0 {
+			t.Errorf("Expected no matches in %q, got %+v", text, matches)
+		}
+	}
+	if !english.Contains("shabi") {
+		t.Error("Expected full spellings of five letters or more to match")
+	}
+}
+
+func TestDetector_PinyinInitials(t *testing.T) {
+	detector, err := New().
+		LoadWords([]dict.Word{
+			{Text: "傻比", Level: dict.LevelHigh},
+			{Text: "你妈死了", Level: dict.LevelCritical, Weight: 20, Tags: []string{"initials"}},
+		}).
+		EnablePinyinInitials(3, "initials").
+		SetPinyinInitialsLevel(dict.LevelMedium).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		text    string
+		found   bool
+		matched string
+	}{
+		{"nmsl", true, "nmsl"},
+		{"NMSL!", true, "NMSL"},
+		{"你妈死了", true, "你妈死了"},
+		{"sb", false, ""},
+		{"shabi", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			matches := detector.Find(tt.text)
+			if (len(matches) > 0) != tt.found {
+				t.Fatalf("Expected found %v, got %+v", tt.found, matches)
+			}
+			if tt.found && (matches[0].Word != "你妈死了" || matches[0].Text != tt.matched) {
+				t.Errorf("Expected 你妈死了 matched as %q, got %q as %q", tt.matched, matches[0].Word, matches[0].Text)
+			}
+		})
+	}
+
+	m := detector.Find("nmsl")[0]
+	if m.Level != dict.LevelMedium || m.Weight != 3 || strings.Join(m.Variants, ",") != "pinyin-initials" {
+		t.Errorf("Expected a medium pinyin-initials match of weight 3, got %+v", m)
+	}
+	if m = detector.Find("你妈死了")[0]; m.Level != dict.LevelCritical || m.Weight != 20 {
+		t.Errorf("Expected the dictionary word to keep its level and weight, got %+v", m)
+	}
+}
+
+func TestDetector_PinyinInitialsMinLength(t *testing.T) {
+	for _, minLength := range []int{-1, 0, 1} {
+		detector, err := New().
+			LoadWords([]dict.Word{{Text: "傻比", Tags: []string{"initials"}}, {Text: "草", Tags: []string{"initials"}}}).
+			EnablePinyinInitials(minLength, "initials").
+			Build()
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+
+		// Single-letter initialisms would flag nearly every text
+		if matches := detector.Find("a cat"); len(matches) != 0 {
+			t.Errorf("Expected minLength %d to be clamped to 2, got %+v", minLength, matches)
+		}
+		if !detector.Contains("sb") {
+			t.Errorf("Expected minLength %d to keep two-letter initialisms", minLength)
+		}
+	}
+}
+
+func TestDetector_PinyinInitialsUntagged(t *testing.T) {
+	detector, err := New().LoadBuiltin().EnablePinyinInitials(2, "").Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	// Initialisms of every builtin word would cover most short English words
+	for _, text := range []string{"ok", "hello world", "I am at the bar", "go to bed"} {
+		if matches := detector.Find(text); len(matches) != 0 {
+			t.Errorf("Expected no match in %q, got %+v", text, matches)
+		}
+	}
+}
+
+func TestDetector_Conversion(t *testing.T) {
+	detector, err := New().
+		LoadMemory([]string{"U盘", "头发"}).
+		SetConversion(variant.ConvertTW2SP).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	text := "買個隨身碟，剪頭髮"
+	matches := detector.Find(text)
+	if len(matches) != 2 {
+		t.Fatalf("Expected 2 matches, got %+v", matches)
+	}
+	for i, expected := range []string{"隨身碟", "頭髮"} {
+		if matches[i].Text != expected || string([]rune(text)[matches[i].Start:matches[i].End]) != expected {
+			t.Errorf("Expected %q, got %q at %d-%d", expected, matches[i].Text, matches[i].Start, matches[i].End)
+		}
+	}
+
+	if filtered := detector.Filter(text); filtered != "買個***，剪**" {
+		t.Errorf("Expected %q, got %q", "買個***，剪**", filtered)
+	}
+
+	// Simplified words keep matching simplified text once conversion is enabled
+	simplified, err := New().LoadMemory([]string{"怎么交女朋友", "什么大冒险"}).EnableVariant().Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	for _, text := range []string{"不主动怎么交女朋友", "什么什么大冒险"} {
+		if !simplified.Contains(text) {
+			t.Errorf("Expected %q to match with conversion enabled", text)
+		}
+	}
+}
+
+func TestDetector_Normalize(t *testing.T) {
+	detector, err := New().
+		LoadMemory([]string{"bad", "café"}).
+		EnableNormalize().
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		text     string
+		word     string
+		filtered string
+	}{
+		{"so ｂａｄ", "bad", "so ***"},
+		{"ⓑⓐⓓ!", "bad", "***!"},
+		{"𝐛𝐚𝐝", "bad", "***"},
+		{"B̸A̷D guy", "bad", "***** guy"},
+		{"CAFE", "café", "****"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			result := detector.FindAll(tt.text)
+			if len(result.Matches) != 1 || result.Matches[0].Word != tt.word {
+				t.Fatalf("Expected %q, got %+v", tt.word, result.Matches)
+			}
+			if result.FilteredText != tt.filtered {
+				t.Errorf("Expected %q, got %q", tt.filtered, result.FilteredText)
+			}
+			if strings.Join(result.Matches[0].Variants, ",") != "normalize" {
+				t.Errorf("Expected variants [normalize], got %v", result.Matches[0].Variants)
+			}
+		})
+	}
+}
+
+func TestDetector_Invisible(t *testing.T) {
+	detector, err := New().
+		LoadMemory([]string{"bad", "敏感词"}).
+		EnableInvisible().
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		text     string
+		matched  string
+		filtered string
+	}{
+		{"so b\u200ba\u200dd!", "b\u200ba\u200dd", "so *****!"},
+		{"敏\ufe0f感\u2060词。", "敏\ufe0f感\u2060词", "*****。"},
+		{"\u202ebad\u202c, ok", "bad", "\u202e***\u202c, ok"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filtered, func(t *testing.T) {
+			result := detector.FindAll(tt.text)
+			if len(result.Matches) != 1 || result.Matches[0].Text != tt.matched {
+				t.Fatalf("Expected %q, got %+v", tt.matched, result.Matches)
+			}
+			if result.FilteredText != tt.filtered {
+				t.Errorf("Expected %q, got %q", tt.filtered, result.FilteredText)
+			}
+		})
+	}
+}
+
+func TestDetector_Confusables(t *testing.T) {
+	detector, err := New().
+		LoadMemory([]string{"paypal", "porn"}).
+		EnableConfusables(false).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		text    string
+		word    string
+		matched string
+	}{
+		{"login to pаypаl now", "paypal", "pаypаl"},
+		{"pay at paypa1", "paypal", "paypa1"},
+		{"free ΡΟRΝ", "porn", "ΡΟRΝ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			matches := detector.Find(tt.text)
+			if len(matches) != 1 || matches[0].Word != tt.word || matches[0].Text != tt.matched {
+				t.Fatalf("Expected %q matched as %q, got %+v", tt.word, tt.matched, matches)
+			}
+			if !containsString(matches[0].Variants, "confusables") {
+				t.Errorf("Expected the confusables variant, got %v", matches[0].Variants)
+			}
+		})
+	}
+
+	crossOnly, err := New().
+		LoadMemory([]string{"paypal"}).
+		EnableConfusables(true).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if crossOnly.Contains("pay at paypa1") || !crossOnly.Contains("login to pаypаl") {
+		t.Errorf("Expected only cross-script look-alikes to match")
+	}
+}
+
+func TestDetector_ConfusablesCorpus(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "homoglyph_evasions.txt"))
+	if err != nil {
+		t.Fatalf("Failed to read corpus: %v", err)
+	}
+
+	var words []string
+	var cases [][2]string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 2)
+		if !containsString(words, fields[0]) {
+			words = append(words, fields[0])
+		}
+		cases = append(cases, [2]string{fields[0], fields[1]})
+	}
+
+	for _, crossOnly := range []bool{false, true} {
+		detector, err := New().LoadMemory(words).EnableConfusables(crossOnly).Build()
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+
+		for _, c := range cases {
+			matches := detector.Find("look: " + c[1] + "!")
+			if len(matches) != 1 || matches[0].Word != c[0] || matches[0].Text != c[1] {
+				t.Errorf("crossOnly=%v: expected %q matched as %q, got %+v", crossOnly, c[0], c[1], matches)
+			}
+		}
+	}
+}
+
+func TestDetector_SimilarRules(t *testing.T) {
+	rules := filepath.Join(t.TempDir(), "similar.txt")
+	if err := os.WriteFile(rules, []byte("鸡 鷄 机\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write rules: %v", err)
+	}
+
+	detector, err := New().
+		LoadMemory([]string{"傻比", "鸡巴", "hello"}).
+		LoadSimilarFile(rules).
+		AddSimilarRule('巴', '吧', '叭').
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	tests := []struct {
+		text     string
+		expected bool
+	}{
+		{"煞笔", true},
+		{"鷄吧", true},
+		{"机叭", true},
+		{"he||o", true},
+		{"hello", true},
+		{"鸡蛋", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if result := detector.Contains(tt.text); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	// Custom rules override the built-in ones; '8' brings its numerals along
+	override, err := New().LoadMemory([]string{"鸡巴"}).AddSimilarRule('巴', '8').Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if !override.Contains("鸡8") || !override.Contains("鸡捌") {
+		t.Error("Expected the custom rule to override the built-in one")
+	}
+
+	// Custom rules that disagree with each other fail the build
+	_, err = New().LoadMemory([]string{"鸡巴"}).AddSimilarRule('巴', '吧').AddSimilarRule('爸', '吧').Build()
+	if err == nil {
+		t.Error("Expected conflicting similar rules to fail the build")
+	}
+}
+
+func TestDetector_SimilarRulesFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"similar.json": `[{"base": "鸡", "similars": ["鷄", "机"]}, {"base": "o", "similars": ["0", "〇"]}]`,
+		"similar.txt":  "# base similars...\n鸡 鷄 机\no 0 〇\n",
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatalf("Failed to write rules: %v", err)
+			}
+
+			detector, err := New().
+				LoadMemory([]string{"鸡巴", "porn"}).
+				LoadSimilarFile(path).
+				Build()
+			if err != nil {
+				t.Fatalf("Build failed: %v", err)
+			}
+
+			for _, text := range []string{"鷄巴", "机巴", "p0rn", "p〇rn", "P○RN"} {
+				if !detector.Contains(text) {
+					t.Errorf("Expected %q to match", text)
+				}
+			}
+		})
+	}
+
+	if _, err := New().LoadMemory([]string{"porn"}).LoadSimilarFile(filepath.Join(dir, "missing.txt")).Build(); err == nil {
+		t.Error("Expected a missing rules file to fail the build")
+	}
+}
+
+func TestDetector_Substitution(t *testing.T) {
+	for _, algo := range []AlgorithmType{AlgorithmDFA, AlgorithmAC} {
+		detector, err := New().
+			UseAlgorithm(algo).
+			LoadMemory([]string{"badword", "shit", "fuck"}).
+			EnableSubstitution(nil).
+			Build()
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+
+		result := detector.FindAll("b4dw0rd, $h1t and phuck")
+		if len(result.Matches) != 3 {
+			t.Fatalf("Expected 3 matches, got %+v", result.Matches)
+		}
+		if result.FilteredText != "*******, **** and *****" {
+			t.Errorf("Expected the whole spellings masked, got %q", result.FilteredText)
+		}
+		for _, m := range result.Matches {
+			if !containsString(m.Variants, algorithm.VariantSubstitution) {
+				t.Errorf("Expected the substitution variant for %s, got %v", m.Word, m.Variants)
+			}
+		}
+	}
+
+	custom, err := New().
+		LoadMemory([]string{"赌博"}).
+		EnableSubstitution(algorithm.Substitutions{'赌': {"du"}}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if !custom.Contains("来du博") || custom.Contains("b4dw0rd") {
+		t.Errorf("Expected only the custom table to apply")
+	}
+	if truncated := custom.TruncatedSubstitutions(); len(truncated) != 0 {
+		t.Errorf("Expected no truncated words, got %v", truncated)
+	}
+
+	// Under the default table "fuck" has 2*2 = 4 spellings and "badword" 3*3*2 = 18
+	for _, algo := range []AlgorithmType{AlgorithmDFA, AlgorithmAC} {
+		limited, err := New().
+			UseAlgorithm(algo).
+			LoadMemory([]string{"badword", "fuck"}).
+			EnableSubstitution(nil).
+			SetSubstitutionLimit(4).
+			Build()
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+
+		truncated := limited.TruncatedSubstitutions()
+		if len(truncated) != 1 || truncated[0] != "badword" {
+			t.Errorf("Expected badword to be reported truncated, got %v", truncated)
+		}
+		if !limited.Contains("b4dword") || !limited.Contains("phuck") {
+			t.Errorf("Expected the spellings with one substitution to be compiled")
+		}
+		if limited.Contains("b4dw0rd") {
+			t.Errorf("Expected spellings beyond the limit to be dropped")
+		}
+	}
+}
+
+func TestDetector_RepeatCollapse(t *testing.T) {
+	for _, algo := range []AlgorithmType{AlgorithmDFA, AlgorithmAC} {
+		detector, err := New().
+			UseAlgorithm(algo).
+			LoadMemory([]string{"bad", "傻比"}).
+			EnableRepeatCollapse(8).
+			Build()
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+
+		result := detector.FindAll("so baaaaad, 傻傻傻比!")
+		if len(result.Matches) != 2 {
+			t.Fatalf("Expected 2 matches, got %+v", result.Matches)
+		}
+		if result.FilteredText != "so *******, ****!" {
+			t.Errorf("Expected the whole runs masked, got %q", result.FilteredText)
+		}
+		if result.Matches[0].Text != "baaaaad" || !containsString(result.Matches[0].Variants, algorithm.VariantRepeat) {
+			t.Errorf("Expected the stretched text with the repeat variant, got %+v", result.Matches[0])
+		}
+	}
+
+	plain, err := New().LoadMemory([]string{"bad"}).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if plain.Contains("baaaaad") {
+		t.Error("Expected repeats not to collapse unless enabled")
+	}
+}
+
+func TestDetector_Explain(t *testing.T) {
+	opts := DefaultOptions()
+	opts.MinLevel = LevelMedium
+	opts.MaxMatchCount = 1
+	opts.EnableSymbolFilter = true
+
+	detector, err := New().
+		LoadWords([]dict.Word{
+			{Text: "敏感词", Level: dict.LevelHigh},
+			{Text: "广告", Level: dict.LevelLow},
+			{Text: "测试", Level: dict.LevelHigh},
+			{Text: "示例", Level: dict.LevelHigh},
+		}).
+		SetOptions(opts).
+		AddWhitelist("示例").
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	explanation := detector.Explain("敏-感-词 广告 示例 测试")
+
+	if len(explanation.Stages) != 1 || explanation.Stages[0].Processor != "symbol" ||
+		explanation.Stages[0].Text != "敏感词 广告 示例 测试" {
+		t.Errorf("Unexpected stages %+v", explanation.Stages)
+	}
+
+	expected := map[string]string{
+		"敏感词": "",
+		"广告":  DropLevel,
+		"示例":  DropFilter,
+		"测试":  DropMaxMatchCount,
+	}
+	if len(explanation.Hits) != len(expected) {
+		t.Fatalf("Expected %d hits, got %+v", len(expected), explanation.Hits)
+	}
+	for _, hit := range explanation.Hits {
+		if hit.DroppedBy != expected[hit.Match.Word] || hit.Kept != (hit.DroppedBy == "") {
+			t.Errorf("Expected %q dropped by %q, got %q (%s)", hit.Match.Word, expected[hit.Match.Word], hit.DroppedBy, hit.Detail)
+		}
+	}
+	if hits := explanation.Kept(); len(hits) != 1 || hits[0].Match.Text != "敏-感-词" {
+		t.Errorf("Expected the kept hit located in the original text, got %+v", hits)
+	}
+
+	if len(explanation.Result.Matches) != 1 || explanation.Decision.Action != ActionReview {
+		t.Errorf("Unexpected result %+v and decision %v", explanation.Result.Matches, explanation.Decision.Action)
+	}
+
+	if _, err := json.Marshal(explanation); err != nil {
+		t.Errorf("Explanation should encode as JSON: %v", err)
+	}
+}
+
+func TestResult_JSON(t *testing.T) {
+	detector, err := New().
+		LoadWords([]dict.Word{
+			{Text: "敏感词", Category: CategoryPolitical.Add(CategoryAd), Level: dict.LevelHigh, Tags: []string{"cn"}},
+		}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	result := detector.FindAll("这是敏感词")
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	for _, fragment := range []string{
+		`"category":"political|ad"`,
+		`"level":"high"`,
+		`"verdict":"review"`,
+		`"byte_start":6`,
+		`"tags":["cn"]`,
+	} {
+		if !strings.Contains(string(data), fragment) {
+			t.Errorf("Expected %s in %s", fragment, data)
+		}
+	}
+
+	var decoded Result
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded.Verdict != result.Verdict || decoded.Matches[0].Category != result.Matches[0].Category ||
+		decoded.Matches[0].Level != result.Matches[0].Level || decoded.Matches[0].Text != "敏感词" {
+		t.Errorf("Expected %+v after round trip, got %+v", result, decoded)
+	}
+}
+
+// panicFilter panics on one word to exercise per-item error reporting
+type panicFilter struct{}
+
+func (panicFilter) ShouldFilter(word string) bool {
+	if word == "测试" {
+		panic("broken filter")
+	}
+	return false
+}
+
+func (panicFilter) Name() string { return "panic" }
+
+func TestDetector_FindBatch(t *testing.T) {
+	detector, err := New().LoadMemory([]string{"敏感词", "测试"}).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	detector.AddFilter(panicFilter{})
+
+	texts := make([]string, 100)
+	for i := range texts {
+		texts[i] = "正常内容"
+		if i%10 == 0 {
+			texts[i] = "包含敏感词"
+		}
+	}
+	texts[55] = "这是测试"
+
+	results, err := detector.FindBatch(context.Background(), texts, BatchOptions{Workers: 4, ItemTimeout: time.Second})
+	if err != nil {
+		t.Fatalf("FindBatch failed: %v", err)
+	}
+
+	for i, r := range results {
+		if r.Index != i {
+			t.Fatalf("Expected results in input order, got index %d at %d", r.Index, i)
+		}
+		if i == 55 {
+			if r.Err == nil {
+				t.Error("Expected the panicking item to report an error")
+			}
+			continue
+		}
+		if r.Err != nil || r.Result.Found != (i%10 == 0) {
+			t.Errorf("Unexpected result at %d: %+v", i, r)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err = detector.FindBatch(ctx, texts, BatchOptions{})
+	if !errors.Is(err, context.Canceled) || !errors.Is(results[len(results)-1].Err, context.Canceled) {
+		t.Errorf("Expected cancellation to be reported, got %v", err)
+	}
+}
+
+func BenchmarkDetector_FindBatch(b *testing.B) {
+	detector, err := New().LoadMemory([]string{"敏感词", "测试", "广告"}).Build()
+	if err != nil {
+		b.Fatalf("Build failed: %v", err)
+	}
+
+	texts := make([]string, 1000)
+	for i := range texts {
+		texts[i] = "这是一段包含敏感词和广告的评论内容，用于批量检测的性能基准测试。"
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		detector.FindBatch(context.Background(), texts, BatchOptions{})
+	}
+}
+
+func BenchmarkDetector_LongLine(b *testing.B) {
+	detector, err := New().LoadMemory([]string{"bad"}).Build()
+	if err != nil {
+		b.Fatalf("Build failed: %v", err)
+	}
+	text := strings.Repeat("bad ", 40000)
+
+	b.Run("FindAll", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			detector.FindAll(text)
+		}
+	})
+	b.Run("Filter", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			detector.Filter(text)
+		}
+	})
+}
+
+func BenchmarkBuild_Pinyin(b *testing.B) {
+	words := builtin.GetDefaultWords()
+
+	for _, enabled := range []bool{false, true} {
+		name := "Plain"
+		if enabled {
+			name = "Pinyin"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			opts := DefaultOptions()
+			opts.EnablePinyin = enabled
+			b.ReportMetric(float64(len(opts.expandWords(words))), "words")
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := New().LoadWords(words).SetOptions(opts).Build(); err != nil {
+					b.Fatalf("Build failed: %v", err)
+				}
+			}
+		})
+	}
+}
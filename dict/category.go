@@ -1,60 +1,356 @@
 package dict
 
-// Category represents the category of sensitive words using bit flags
-type Category int
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MaxCategories is the number of distinct categories that can be registered
+const MaxCategories = 256
+
+// Category is a set of categories
+// Each registered category owns one bit, indexed by its ID; the zero value is the empty set
+type Category struct {
+	bits [MaxCategories / 64]uint64
+}
 
-const (
+// Built-in categories, registered with IDs 0-6 so that legacy bitmask values keep their meaning
+var (
 	// CategoryPolitical represents political sensitive words
-	CategoryPolitical Category = 1 << iota
+	CategoryPolitical = MustRegisterCategoryID(0, "political")
 	// CategoryPornographic represents pornographic content
-	CategoryPornographic
+	CategoryPornographic = MustRegisterCategoryID(1, "pornographic")
 	// CategoryViolence represents violence and gore
-	CategoryViolence
+	CategoryViolence = MustRegisterCategoryID(2, "violence")
 	// CategoryAbuse represents abusive and insulting words
-	CategoryAbuse
+	CategoryAbuse = MustRegisterCategoryID(3, "abuse")
 	// CategoryAd represents advertisement and spam
-	CategoryAd
+	CategoryAd = MustRegisterCategoryID(4, "ad")
 	// CategoryIllegal represents illegal activities
-	CategoryIllegal
+	CategoryIllegal = MustRegisterCategoryID(5, "illegal")
 	// CategoryOther represents other categories
-	CategoryOther
+	CategoryOther = MustRegisterCategoryID(6, "other")
 )
 
+// registry holds the registered category names
+var registry = struct {
+	mu    sync.RWMutex
+	names [MaxCategories]string
+	ids   map[string]int
+}{ids: make(map[string]int)}
+
+// RegisterCategory registers a category under the lowest free ID
+// Registering a name that already exists returns the existing category
+func RegisterCategory(name string) (Category, error) {
+	name, err := normalizeCategoryName(name)
+	if err != nil {
+		return Category{}, err
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if id, exists := registry.ids[name]; exists {
+		return CategoryFromID(id), nil
+	}
+
+	for id := range registry.names {
+		if registry.names[id] == "" {
+			registry.names[id] = name
+			registry.ids[name] = id
+			return CategoryFromID(id), nil
+		}
+	}
+	return Category{}, fmt.Errorf("cannot register %q: all %d category IDs are in use", name, MaxCategories)
+}
+
+// RegisterCategoryID registers a category under an explicit ID
+// Registering the same name and ID again is a no-op; any other reuse is an error
+func RegisterCategoryID(id int, name string) (Category, error) {
+	name, err := normalizeCategoryName(name)
+	if err != nil {
+		return Category{}, err
+	}
+	if id < 0 || id >= MaxCategories {
+		return Category{}, fmt.Errorf("category ID %d out of range [0, %d)", id, MaxCategories)
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if existing, exists := registry.ids[name]; exists && existing != id {
+		return Category{}, fmt.Errorf("category %q is already registered with ID %d", name, existing)
+	}
+	if current := registry.names[id]; current != "" && current != name {
+		return Category{}, fmt.Errorf("category ID %d is already registered as %q", id, current)
+	}
+
+	registry.names[id] = name
+	registry.ids[name] = id
+	return CategoryFromID(id), nil
+}
+
+// MustRegisterCategory is like RegisterCategory but panics on error
+func MustRegisterCategory(name string) Category {
+	category, err := RegisterCategory(name)
+	if err != nil {
+		panic(err)
+	}
+	return category
+}
+
+// MustRegisterCategoryID is like RegisterCategoryID but panics on error
+func MustRegisterCategoryID(id int, name string) Category {
+	category, err := RegisterCategoryID(id, name)
+	if err != nil {
+		panic(err)
+	}
+	return category
+}
+
+// LookupCategory returns the registered category with the given name
+func LookupCategory(name string) (Category, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	id, exists := registry.ids[strings.ToLower(strings.TrimSpace(name))]
+	if !exists {
+		return Category{}, false
+	}
+	return CategoryFromID(id), true
+}
+
+// RegisteredCategories returns every registered category, ordered by ID
+func RegisteredCategories() []Category {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	categories := make([]Category, 0, len(registry.ids))
+	for id, name := range registry.names {
+		if name != "" {
+			categories = append(categories, CategoryFromID(id))
+		}
+	}
+	return categories
+}
+
+// normalizeCategoryName lower-cases a name and rejects names that would not parse back
+func normalizeCategoryName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", fmt.Errorf("empty category name")
+	}
+	if strings.ContainsAny(name, ",|#") {
+		return "", fmt.Errorf("category name %q must not contain ',', '|' or '#'", name)
+	}
+	if _, err := strconv.Atoi(name); err == nil {
+		return "", fmt.Errorf("category name %q must not be numeric", name)
+	}
+	return name, nil
+}
+
+// CategoryFromID returns the single-category set for an ID
+// IDs outside [0, MaxCategories) yield the empty set
+func CategoryFromID(id int) Category {
+	var c Category
+	if id >= 0 && id < MaxCategories {
+		c.bits[id/64] = 1 << (id % 64)
+	}
+	return c
+}
+
+// CategoryFromMask converts a legacy bitmask, where bit n is category ID n
+func CategoryFromMask(mask uint64) Category {
+	var c Category
+	c.bits[0] = mask
+	return c
+}
+
+// Mask returns the set as a legacy bitmask; categories with IDs of 64 and above are dropped
+func (c Category) Mask() uint64 {
+	return c.bits[0]
+}
+
+// IDs returns the IDs of the categories in the set, in ascending order
+func (c Category) IDs() []int {
+	ids := make([]int, 0, c.Count())
+	for i, word := range c.bits {
+		for word != 0 {
+			bit := bits.TrailingZeros64(word)
+			ids = append(ids, i*64+bit)
+			word &^= 1 << bit
+		}
+	}
+	return ids
+}
+
+// Count returns the number of categories in the set
+func (c Category) Count() int {
+	count := 0
+	for _, word := range c.bits {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// IsZero reports whether the set is empty
+func (c Category) IsZero() bool {
+	return c == Category{}
+}
+
+// Names returns the names of the categories in the set, in ID order
+// Unregistered IDs are rendered as "#<id>"
+func (c Category) Names() []string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	ids := c.IDs()
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		if name := registry.names[id]; name != "" {
+			names[i] = name
+		} else {
+			names[i] = "#" + strconv.Itoa(id)
+		}
+	}
+	return names
+}
+
 // String returns the string representation of the category
+// Several categories are joined with "|"; the empty set is "unknown"
 func (c Category) String() string {
-	switch c {
-	case CategoryPolitical:
-		return "political"
-	case CategoryPornographic:
-		return "pornographic"
-	case CategoryViolence:
-		return "violence"
-	case CategoryAbuse:
-		return "abuse"
-	case CategoryAd:
-		return "ad"
-	case CategoryIllegal:
-		return "illegal"
-	case CategoryOther:
-		return "other"
-	default:
+	if c.IsZero() {
 		return "unknown"
 	}
+	return strings.Join(c.Names(), "|")
 }
 
-// Has checks if the category contains the specified flag
+// Has checks if the category shares any flag with the specified category
 func (c Category) Has(flag Category) bool {
-	return c&flag != 0
+	for i := range c.bits {
+		if c.bits[i]&flag.bits[i] != 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // Add adds a category flag
 func (c Category) Add(flag Category) Category {
-	return c | flag
+	for i := range c.bits {
+		c.bits[i] |= flag.bits[i]
+	}
+	return c
 }
 
 // Remove removes a category flag
 func (c Category) Remove(flag Category) Category {
-	return c &^ flag
+	for i := range c.bits {
+		c.bits[i] &^= flag.bits[i]
+	}
+	return c
 }
 
+// ParseCategory parses a category name such as "political", a list of names
+// separated by "," or "|", "#<id>" for an ID, or a numeric legacy bitmask
+func ParseCategory(s string) (Category, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Category{}, fmt.Errorf("empty category")
+	}
 
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return CategoryFromMask(n), nil
+	}
+
+	var category Category
+	for _, name := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '|' }) {
+		name = strings.TrimSpace(name)
+
+		if strings.HasPrefix(name, "#") {
+			id, err := strconv.Atoi(name[1:])
+			if err != nil || id < 0 || id >= MaxCategories {
+				return Category{}, fmt.Errorf("invalid category ID: %q", name)
+			}
+			category = category.Add(CategoryFromID(id))
+			continue
+		}
+
+		flag, exists := LookupCategory(name)
+		if !exists {
+			return Category{}, fmt.Errorf("unknown category: %q", name)
+		}
+		category = category.Add(flag)
+	}
+
+	return category, nil
+}
+
+// MarshalText encodes the category as its "|"-separated names; the empty set is ""
+func (c Category) MarshalText() ([]byte, error) {
+	if c.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes "|"- or ","-separated names, "#<id>" or a numeric legacy bitmask
+func (c *Category) UnmarshalText(text []byte) error {
+	if strings.TrimSpace(string(text)) == "" {
+		*c = Category{}
+		return nil
+	}
+	parsed, err := ParseCategory(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalJSON encodes the category as a string of its "|"-separated names
+func (c Category) MarshalJSON() ([]byte, error) {
+	text, _ := c.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON accepts a name string, an array of names, or a legacy numeric bitmask
+func (c *Category) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		*c = Category{}
+	case float64:
+		if v < 0 || v != float64(uint64(v)) {
+			return fmt.Errorf("invalid category bitmask: %v", v)
+		}
+		*c = CategoryFromMask(uint64(v))
+	case string:
+		return c.UnmarshalText([]byte(v))
+	case []interface{}:
+		var category Category
+		for _, item := range v {
+			name, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("invalid category list element: %v", item)
+			}
+			parsed, err := ParseCategory(name)
+			if err != nil {
+				return err
+			}
+			category = category.Add(parsed)
+		}
+		*c = category
+	default:
+		return fmt.Errorf("invalid category: %s", data)
+	}
+	return nil
+}
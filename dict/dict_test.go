@@ -1,6 +1,10 @@
 package dict
 
-import "testing"
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
 
 func TestCategory_String(t *testing.T) {
 	tests := []struct {
@@ -20,7 +24,7 @@ func TestCategory_String(t *testing.T) {
 }
 
 func TestCategory_Has(t *testing.T) {
-	cat := CategoryPolitical | CategoryViolence
+	cat := CategoryPolitical.Add(CategoryViolence)
 
 	if !cat.Has(CategoryPolitical) {
 		t.Error("Should have Political category")
@@ -50,3 +54,304 @@ func TestLevel_String(t *testing.T) {
 }
 
 
+
+func TestParseCategory(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Category
+		wantErr  bool
+	}{
+		{"political", CategoryPolitical, false},
+		{"Ad|Other", CategoryAd.Add(CategoryOther), false},
+		{"violence, abuse", CategoryViolence.Add(CategoryAbuse), false},
+		{"3", CategoryPolitical.Add(CategoryPornographic), false},
+		{"#6", CategoryOther, false},
+		{"gambling", Category{}, true},
+		{"", Category{}, true},
+	}
+
+	for _, tt := range tests {
+		category, err := ParseCategory(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseCategory(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if category != tt.expected {
+			t.Errorf("ParseCategory(%q) = %v, expected %v", tt.input, category, tt.expected)
+		}
+	}
+}
+
+func TestCategoryRegistry(t *testing.T) {
+	fraud, err := RegisterCategory("fraud")
+	if err != nil {
+		t.Fatalf("RegisterCategory failed: %v", err)
+	}
+	again, err := RegisterCategory("Fraud")
+	if err != nil || again != fraud {
+		t.Errorf("Expected re-registering to return the same category, got %v, %v", again, err)
+	}
+
+	// IDs above 63 do not fit the legacy bitmask
+	minor, err := RegisterCategoryID(200, "minor-safety")
+	if err != nil {
+		t.Fatalf("RegisterCategoryID failed: %v", err)
+	}
+	if minor.Mask() != 0 || minor.IDs()[0] != 200 {
+		t.Errorf("Expected ID 200 outside the legacy mask, got %v", minor.IDs())
+	}
+	if _, err := RegisterCategoryID(200, "other-name"); err == nil {
+		t.Error("Expected error when reusing an ID")
+	}
+	if _, err := RegisterCategoryID(0, "political"); err != nil {
+		t.Errorf("Expected re-registering a built-in with its ID to succeed, got %v", err)
+	}
+	if _, err := RegisterCategory("a|b"); err == nil {
+		t.Error("Expected error for a name containing a separator")
+	}
+
+	cat := CategoryAd.Add(fraud).Add(minor)
+	if cat.Count() != 3 || !cat.Has(minor) || cat.Has(CategoryPolitical) {
+		t.Errorf("Unexpected set %v", cat)
+	}
+	if cat.String() != "ad|fraud|minor-safety" {
+		t.Errorf("Expected ad|fraud|minor-safety, got %s", cat.String())
+	}
+
+	parsed, err := ParseCategory(cat.String())
+	if err != nil || parsed != cat {
+		t.Errorf("Expected %v to round-trip, got %v, %v", cat, parsed, err)
+	}
+
+	data, err := json.Marshal(Word{Text: "x", Category: cat})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var word Word
+	if err := json.Unmarshal(data, &word); err != nil || word.Category != cat {
+		t.Errorf("Expected JSON round-trip of %v, got %v, %v", cat, word.Category, err)
+	}
+
+	for input, expected := range map[string]Category{
+		`1`:               CategoryPolitical,
+		`["ad", "fraud"]`: CategoryAd.Add(fraud),
+		`"violence,#200"`: CategoryViolence.Add(minor),
+		`""`:              {},
+	} {
+		var c Category
+		if err := json.Unmarshal([]byte(input), &c); err != nil || c != expected {
+			t.Errorf("Unmarshal(%s) = %v, %v, expected %v", input, c, err, expected)
+		}
+	}
+}
+
+func TestLevel_Text(t *testing.T) {
+	tests := []struct {
+		level    Level
+		expected string
+	}{
+		{LevelLow, "low"},
+		{LevelCritical, "critical"},
+		{Level(7), "7"},
+	}
+
+	for _, tt := range tests {
+		text, err := tt.level.MarshalText()
+		if err != nil || string(text) != tt.expected {
+			t.Errorf("Expected %s, got %s (%v)", tt.expected, text, err)
+		}
+
+		var level Level
+		if err := level.UnmarshalText(text); err != nil || level != tt.level {
+			t.Errorf("Expected %v after round trip, got %v (%v)", tt.level, level, err)
+		}
+	}
+
+	var word Word
+	if err := json.Unmarshal([]byte(`{"Text": "x", "Level": 2}`), &word); err != nil || word.Level != LevelHigh {
+		t.Errorf("Expected numeric level to decode, got %v (%v)", word.Level, err)
+	}
+
+	// Custom levels survive a JSON round trip as decimal strings
+	data, err := json.Marshal(Word{Text: "x", Level: Level(5)})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var custom Word
+	if err := json.Unmarshal(data, &custom); err != nil || custom.Level != Level(5) {
+		t.Errorf("Expected custom level 5 after round trip of %s, got %v (%v)", data, custom.Level, err)
+	}
+	if err := json.Unmarshal([]byte(`{"Text": "x", "Level": 999}`), &custom); err != nil || custom.Level != Level(999) {
+		t.Errorf("Expected numeric custom level to decode, got %v (%v)", custom.Level, err)
+	}
+
+	for _, data := range []string{`{"Text": "x", "Level": -5}`, `{"Text": "x", "Level": 1.5}`} {
+		if err := json.Unmarshal([]byte(data), &word); err == nil {
+			t.Errorf("Expected %s to be rejected", data)
+		}
+	}
+}
+
+func TestWord_JSON(t *testing.T) {
+	word := Word{
+		Text:     "敏感词",
+		Category: CategoryPolitical,
+		Level:    LevelHigh,
+		Tags:     []string{"cn"},
+		Weight:   6,
+		Source:   "words.txt",
+		Base:     "敏感",
+		Variant:  "pinyin",
+	}
+
+	data, err := json.Marshal(word)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	expected := `{"text":"敏感词","category":"political","level":"high","tags":["cn"],"weight":6}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	var decoded Word
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !decoded.Equal(word) || decoded.Source != "" || decoded.Base != "" || decoded.Variant != "" {
+		t.Errorf("Expected %+v without internal fields, got %+v", word, decoded)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Level
+		wantErr  bool
+	}{
+		{"high", LevelHigh, false},
+		{"Critical", LevelCritical, false},
+		{"1", LevelMedium, false},
+		{"3", LevelCritical, false},
+		{"-5", 0, true},
+		{"5", Level(5), false},
+		{"severe", 0, true},
+	}
+
+	for _, tt := range tests {
+		level, err := ParseLevel(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLevel(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if level != tt.expected {
+			t.Errorf("ParseLevel(%q) = %v, expected %v", tt.input, level, tt.expected)
+		}
+	}
+}
+
+func TestLint(t *testing.T) {
+	content := `# sample dictionary
+敏感词
+Spam
+敏感词
+spam
+傻
+!!!
+
+测试
+`
+	entries, err := ParseEntries(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseEntries failed: %v", err)
+	}
+	if len(entries) != 7 || entries[0].Line != 2 {
+		t.Fatalf("Expected 7 entries starting at line 2, got %d", len(entries))
+	}
+
+	report := Lint(entries, LintOptions{
+		CommonPhrases: []string{"测试一下"},
+		Corpus:        []string{"我们来测试", "正常文本", "spam 邮件"},
+		MaxHitRate:    0.5,
+	})
+
+	expected := map[string]int{
+		RuleDuplicate:     4,
+		RuleCaseDuplicate: 5,
+		RuleTooShort:      6,
+		RuleNoContent:     7,
+		RuleCommonPhrase:  9,
+	}
+	for _, issue := range report.Issues {
+		if line, ok := expected[issue.Rule]; ok && line == issue.Line {
+			delete(expected, issue.Rule)
+		}
+	}
+	for rule, line := range expected {
+		t.Errorf("Expected %s issue on line %d, got %v", rule, line, report.Issues)
+	}
+
+	if report.Count(SeverityError) != 1 {
+		t.Errorf("Expected 1 error, got %d", report.Count(SeverityError))
+	}
+
+	if len(report.Impact) != 2 || report.Impact[0].Hits != 1 {
+		t.Errorf("Expected 2 entries with corpus hits, got %+v", report.Impact)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := []Word{
+		{Text: "敏感词", Category: CategoryOther, Level: LevelMedium},
+		{Text: "测试", Category: CategoryOther, Level: LevelLow},
+		{Text: "广告", Category: CategoryAd, Level: LevelLow, Tags: []string{"a", "b"}},
+	}
+	new := []Word{
+		{Text: "敏感词", Category: CategoryPolitical, Level: LevelMedium},
+		{Text: "广告", Category: CategoryAd, Level: LevelLow, Tags: []string{"b", "a"}},
+		{Text: "新词", Category: CategoryOther, Level: LevelHigh},
+	}
+
+	changes := Diff(old, new)
+	if len(changes.Added) != 1 || changes.Added[0].Text != "新词" {
+		t.Errorf("Expected '新词' to be added, got %+v", changes.Added)
+	}
+	if len(changes.Removed) != 1 || changes.Removed[0].Text != "测试" {
+		t.Errorf("Expected '测试' to be removed, got %+v", changes.Removed)
+	}
+	if len(changes.Modified) != 1 || changes.Modified[0].New.Category != CategoryPolitical {
+		t.Errorf("Expected '敏感词' to be modified, got %+v", changes.Modified)
+	}
+
+	// Round-trip through JSON
+	var buf strings.Builder
+	if err := changes.Encode(&buf); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	decoded, err := DecodeChangeSet(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatalf("DecodeChangeSet failed: %v", err)
+	}
+
+	applied, err := decoded.Apply(old)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if !Diff(applied, new).IsEmpty() {
+		t.Errorf("Applying the change set should reproduce the new set, got %+v", applied)
+	}
+
+	// The change set no longer fits once applied
+	if _, err := decoded.Apply(applied); err == nil {
+		t.Error("Expected error when applying the change set twice")
+	}
+
+	// A modification computed from an older definition is stale
+	modified := &ChangeSet{Modified: changes.Modified}
+	edited := []Word{{Text: "敏感词", Category: CategoryAbuse, Level: LevelHigh}}
+	if _, err := modified.Apply(edited); err == nil {
+		t.Error("Expected error when the modified entry changed since the diff")
+	}
+	if _, err := modified.Apply(old); err != nil {
+		t.Errorf("Expected the modification to apply to its old definition, got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package dict
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Change records an entry whose category, level or tags changed
+type Change struct {
+	Old Word `json:"old"`
+	New Word `json:"new"`
+}
+
+// ChangeSet describes how one dictionary version differs from another
+type ChangeSet struct {
+	CreatedAt time.Time `json:"created_at"`         // When the diff was computed
+	Added     []Word    `json:"added,omitempty"`    // Entries only in the new version
+	Removed   []Word    `json:"removed,omitempty"`  // Entries only in the old version
+	Modified  []Change  `json:"modified,omitempty"` // Entries present in both with a different definition
+}
+
+// Diff compares two word sets by text and returns the changes from old to new
+// When a set contains the same text more than once, its first entry is used
+func Diff(old, new []Word) *ChangeSet {
+	oldIndex := indexWords(old)
+	newIndex := indexWords(new)
+
+	changes := &ChangeSet{CreatedAt: time.Now()}
+	seen := make(map[string]bool, len(new))
+
+	for _, word := range new {
+		if seen[word.Text] {
+			continue
+		}
+		seen[word.Text] = true
+
+		previous, exists := oldIndex[word.Text]
+		if !exists {
+			changes.Added = append(changes.Added, word)
+		} else if !previous.Equal(word) {
+			changes.Modified = append(changes.Modified, Change{Old: previous, New: word})
+		}
+	}
+
+	for _, word := range old {
+		if _, exists := newIndex[word.Text]; !exists && !seen[word.Text] {
+			seen[word.Text] = true
+			changes.Removed = append(changes.Removed, word)
+		}
+	}
+
+	return changes
+}
+
+// indexWords maps each text to its first entry
+func indexWords(words []Word) map[string]Word {
+	index := make(map[string]Word, len(words))
+	for _, word := range words {
+		if _, exists := index[word.Text]; !exists {
+			index[word.Text] = word
+		}
+	}
+	return index
+}
+
+// Len returns the number of changed entries
+func (c *ChangeSet) Len() int {
+	return len(c.Added) + len(c.Removed) + len(c.Modified)
+}
+
+// IsEmpty reports whether the change set contains no changes
+func (c *ChangeSet) IsEmpty() bool {
+	return c.Len() == 0
+}
+
+// Apply applies the change set to words and returns the resulting word set
+// It fails without modifying anything if the change set does not fit words:
+// an added entry already exists, a removed or modified entry is missing, or a
+// modified entry no longer has the definition the change was computed from
+func (c *ChangeSet) Apply(words []Word) ([]Word, error) {
+	index := indexWords(words)
+
+	for _, word := range c.Added {
+		if _, exists := index[word.Text]; exists {
+			return nil, fmt.Errorf("cannot add %q: entry already exists", word.Text)
+		}
+	}
+
+	drop := make(map[string]bool, len(c.Removed))
+	for _, word := range c.Removed {
+		if _, exists := index[word.Text]; !exists {
+			return nil, fmt.Errorf("cannot remove %q: entry does not exist", word.Text)
+		}
+		drop[word.Text] = true
+	}
+
+	replace := make(map[string]Word, len(c.Modified))
+	for _, change := range c.Modified {
+		current, exists := index[change.New.Text]
+		if !exists {
+			return nil, fmt.Errorf("cannot modify %q: entry does not exist", change.New.Text)
+		}
+		if !current.Equal(change.Old) {
+			return nil, fmt.Errorf("cannot modify %q: entry changed since the diff", change.New.Text)
+		}
+		replace[change.New.Text] = change.New
+	}
+
+	result := make([]Word, 0, len(words)+len(c.Added)-len(c.Removed))
+	for _, word := range words {
+		if drop[word.Text] {
+			continue
+		}
+		if updated, exists := replace[word.Text]; exists {
+			word = updated
+		}
+		result = append(result, word)
+	}
+	result = append(result, c.Added...)
+
+	return result, nil
+}
+
+// Encode writes the change set as JSON
+func (c *ChangeSet) Encode(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(c)
+}
+
+// DecodeChangeSet reads a change set written by Encode
+func DecodeChangeSet(r io.Reader) (*ChangeSet, error) {
+	var changes ChangeSet
+	if err := json.NewDecoder(r).Decode(&changes); err != nil {
+		return nil, fmt.Errorf("failed to parse change set: %w", err)
+	}
+	return &changes, nil
+}
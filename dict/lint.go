@@ -0,0 +1,305 @@
+package dict
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Severity ranks lint findings
+type Severity int
+
+const (
+	// SeverityInfo is an observation that needs no action
+	SeverityInfo Severity = iota
+	// SeverityWarning is an entry that is likely to cause false positives or bloat
+	SeverityWarning
+	// SeverityError is an entry that is broken and should be removed
+	SeverityError
+)
+
+// String returns the string representation of the severity
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the severity by name
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Lint rule names
+const (
+	RuleDuplicate     = "duplicate"      // Exact duplicate of an earlier entry
+	RuleCaseDuplicate = "case-duplicate" // Differs from an earlier entry only in case
+	RuleTooShort      = "too-short"      // Shorter than LintOptions.MinLength runes
+	RuleNoContent     = "no-content"     // Only whitespace, symbols or invisible characters
+	RuleCommonPhrase  = "common-phrase"  // Substring of a common phrase
+	RuleFalsePositive = "false-positive" // Matches too much of the sample corpus
+)
+
+// Entry is a word together with the line it was read from
+type Entry struct {
+	Word Word
+	Line int // 1-based line number, 0 when unknown
+}
+
+// LintIssue is a single lint finding
+type LintIssue struct {
+	Line     int      // 1-based line number, 0 when unknown
+	Text     string   // The offending entry
+	Rule     string   // Name of the rule that fired
+	Severity Severity // How serious the finding is
+	Message  string   // Human readable explanation
+}
+
+// String returns the issue formatted as "line: severity: rule: message"
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%d: %s: %s: %s", i.Line, i.Severity, i.Rule, i.Message)
+}
+
+// Impact estimates how often an entry fires on the sample corpus
+type Impact struct {
+	Line int     // Line of the entry
+	Text string  // The entry
+	Hits int     // Number of corpus samples containing the entry
+	Rate float64 // Hits divided by the corpus size
+}
+
+// LintOptions configures Lint
+type LintOptions struct {
+	// MinLength is the minimum entry length in runes (default 2)
+	MinLength int
+
+	// CommonPhrases lists everyday phrases; entries found inside them are reported
+	CommonPhrases []string
+
+	// Corpus is a sample of legitimate text used to estimate false positives
+	Corpus []string
+
+	// MaxHitRate is the fraction of corpus samples an entry may match before
+	// it is reported (default 0.01)
+	MaxHitRate float64
+
+	// CaseSensitive keeps entries that differ only in case apart
+	CaseSensitive bool
+}
+
+// LintReport holds the result of Lint
+type LintReport struct {
+	Issues []LintIssue // Findings ordered by line
+	Impact []Impact    // Corpus hits per entry, highest first (empty without a corpus)
+}
+
+// Count returns the number of issues at or above the given severity
+func (r *LintReport) Count(min Severity) int {
+	count := 0
+	for _, issue := range r.Issues {
+		if issue.Severity >= min {
+			count++
+		}
+	}
+	return count
+}
+
+// ParseEntries reads a plain text dictionary, one word per line, keeping line numbers
+// Empty lines and comments are skipped the same way the loaders skip them
+func ParseEntries(r io.Reader) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		entries = append(entries, Entry{
+			Word: Word{Text: line, Category: CategoryOther, Level: LevelMedium},
+			Line: lineNo,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read dictionary: %w", err)
+	}
+
+	return entries, nil
+}
+
+// LintWords lints a word list, using each word's 1-based index as its line number
+func LintWords(words []Word, opts LintOptions) *LintReport {
+	entries := make([]Entry, len(words))
+	for i, word := range words {
+		entries[i] = Entry{Word: word, Line: i + 1}
+	}
+	return Lint(entries, opts)
+}
+
+// Lint checks dictionary entries for duplicates, junk and likely false positives
+func Lint(entries []Entry, opts LintOptions) *LintReport {
+	if opts.MinLength <= 0 {
+		opts.MinLength = 2
+	}
+	if opts.MaxHitRate <= 0 {
+		opts.MaxHitRate = 0.01
+	}
+
+	report := &LintReport{}
+	add := func(entry Entry, rule string, severity Severity, format string, args ...interface{}) {
+		report.Issues = append(report.Issues, LintIssue{
+			Line:     entry.Line,
+			Text:     entry.Word.Text,
+			Rule:     rule,
+			Severity: severity,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	exact := make(map[string]int)  // text -> index of first entry
+	folded := make(map[string]int) // lower-cased text -> index of first entry
+	index := make(map[string][]int)
+	maxLen := 0
+
+	for i, entry := range entries {
+		text := entry.Word.Text
+
+		if !hasContent(text) {
+			add(entry, RuleNoContent, SeverityError, "entry %q contains no letters or digits", text)
+			continue
+		}
+
+		if first, exists := exact[text]; exists {
+			add(entry, RuleDuplicate, SeverityWarning, "duplicate of line %d", entries[first].Line)
+			continue
+		}
+		exact[text] = i
+
+		key := strings.ToLower(text)
+		if first, exists := folded[key]; exists && !opts.CaseSensitive {
+			add(entry, RuleCaseDuplicate, SeverityWarning, "differs from line %d (%q) only in case",
+				entries[first].Line, entries[first].Word.Text)
+			continue
+		} else if !exists {
+			folded[key] = i
+		}
+
+		n := utf8.RuneCountInString(text)
+		if n < opts.MinLength {
+			add(entry, RuleTooShort, SeverityWarning, "%d-character entry matches inside many unrelated words", n)
+		}
+		if n > maxLen {
+			maxLen = n
+		}
+
+		if opts.CaseSensitive {
+			key = text
+		}
+		index[key] = append(index[key], i)
+	}
+
+	if len(opts.CommonPhrases) > 0 {
+		reported := make(map[int]bool)
+		for _, phrase := range opts.CommonPhrases {
+			scanSubstrings(phrase, index, maxLen, opts.CaseSensitive, func(i int) {
+				if reported[i] {
+					return
+				}
+				reported[i] = true
+				add(entries[i], RuleCommonPhrase, SeverityWarning, "appears in common phrase %q", phrase)
+			})
+		}
+	}
+
+	if len(opts.Corpus) > 0 {
+		hits := make([]int, len(entries))
+		lastSample := make([]int, len(entries))
+		for i := range lastSample {
+			lastSample[i] = -1
+		}
+
+		for n, sample := range opts.Corpus {
+			scanSubstrings(sample, index, maxLen, opts.CaseSensitive, func(i int) {
+				if lastSample[i] != n {
+					lastSample[i] = n
+					hits[i]++
+				}
+			})
+		}
+
+		total := float64(len(opts.Corpus))
+		for i, count := range hits {
+			if count == 0 {
+				continue
+			}
+			rate := float64(count) / total
+			report.Impact = append(report.Impact, Impact{
+				Line: entries[i].Line,
+				Text: entries[i].Word.Text,
+				Hits: count,
+				Rate: rate,
+			})
+			if rate > opts.MaxHitRate {
+				add(entries[i], RuleFalsePositive, SeverityWarning,
+					"matches %d of %d corpus samples (%.2f%%)", count, len(opts.Corpus), rate*100)
+			}
+		}
+
+		sort.SliceStable(report.Impact, func(i, j int) bool {
+			return report.Impact[i].Hits > report.Impact[j].Hits
+		})
+	}
+
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		return report.Issues[i].Line < report.Issues[j].Line
+	})
+
+	return report
+}
+
+// hasContent reports whether text contains at least one letter or digit
+func hasContent(text string) bool {
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// scanSubstrings calls fn for every indexed entry that occurs in text
+func scanSubstrings(text string, index map[string][]int, maxLen int, caseSensitive bool, fn func(int)) {
+	if !caseSensitive {
+		text = strings.ToLower(text)
+	}
+
+	// Byte offsets of rune boundaries, so substrings can be sliced without allocating
+	bounds := make([]int, 0, len(text)+1)
+	for i := range text {
+		bounds = append(bounds, i)
+	}
+	bounds = append(bounds, len(text))
+
+	for start := 0; start < len(bounds)-1; start++ {
+		for end := start + 1; end < len(bounds) && end-start <= maxLen; end++ {
+			for _, i := range index[text[bounds[start]:bounds[end]]] {
+				fn(i)
+			}
+		}
+	}
+}
@@ -1,11 +1,33 @@
 package dict
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
 // Word represents a sensitive word with metadata
 type Word struct {
-	Text     string   // The sensitive word text
-	Category Category // Category of the word
-	Level    Level    // Severity level
-	Tags     []string // Custom tags for the word
+	Text     string   `json:"text"`           // The sensitive word text
+	Category Category `json:"category"`       // Category of the word
+	Level    Level    `json:"level"`          // Severity level
+	Tags     []string `json:"tags,omitempty"` // Custom tags for the word
+	Weight   float64  `json:"weight"`         // Risk weight used for scoring (0 means use the weight of the level)
+	Source   string   `json:"-"`              // Name of the source the word was loaded from (set when sources are merged)
+	Base     string   `json:"-"`              // Dictionary word a derived form was generated from (empty for dictionary words)
+	Variant  string   `json:"-"`              // Variant that generated a derived form, such as "pinyin"
+}
+
+// Canonical returns the dictionary word of w: Base for derived forms, otherwise Text
+func (w *Word) Canonical() string {
+	if w.Base != "" {
+		return w.Base
+	}
+	return w.Text
 }
 
 // Level represents the severity level of a sensitive word
@@ -38,4 +60,127 @@ func (l Level) String() string {
 	}
 }
 
+// Valid reports whether l is a defined or custom level
+// Levels above LevelCritical are custom levels; negative levels are invalid
+func (l Level) Valid() bool {
+	return l >= LevelLow
+}
+
+// ParseLevel parses a level name such as "high" or its numeric value
+// Numbers above LevelCritical are custom levels; negative numbers are rejected
+func ParseLevel(s string) (Level, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		return levelOf(n)
+	}
+
+	switch strings.ToLower(s) {
+	case "low":
+		return LevelLow, nil
+	case "medium":
+		return LevelMedium, nil
+	case "high":
+		return LevelHigh, nil
+	case "critical":
+		return LevelCritical, nil
+	default:
+		return 0, fmt.Errorf("unknown level: %q", s)
+	}
+}
+
+// levelOf converts a numeric level, rejecting negative numbers
+func levelOf(n int) (Level, error) {
+	if level := Level(n); level.Valid() {
+		return level, nil
+	}
+	return 0, fmt.Errorf("level %d is negative", n)
+}
+
+// MarshalText encodes the level by name; levels without a name are encoded as their number
+func (l Level) MarshalText() ([]byte, error) {
+	if name := l.String(); name != "unknown" {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(l))), nil
+}
+
+// UnmarshalText decodes a level name or number
+func (l *Level) UnmarshalText(text []byte) error {
+	level, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*l = level
+	return nil
+}
+
+// UnmarshalJSON accepts a level name such as "high" or its numeric value
+func (l *Level) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if v != float64(int(v)) {
+			return fmt.Errorf("invalid level: %s", data)
+		}
+		level, err := levelOf(int(v))
+		if err != nil {
+			return err
+		}
+		*l = level
+	case string:
+		level, err := ParseLevel(v)
+		if err != nil {
+			return err
+		}
+		*l = level
+	default:
+		return fmt.Errorf("invalid level: %s", data)
+	}
+	return nil
+}
+
+// Equal reports whether two words have the same text, category, level, weight and tags
+// Tags are compared as a set; the source is ignored
+func (w Word) Equal(other Word) bool {
+	if w.Text != other.Text || w.Category != other.Category || w.Level != other.Level || w.Weight != other.Weight {
+		return false
+	}
+	if len(w.Tags) != len(other.Tags) {
+		return false
+	}
+	for _, tag := range w.Tags {
+		found := false
+		for _, t := range other.Tags {
+			if t == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
 
+// Fingerprint returns an order-independent SHA-256 fingerprint of a word set
+func Fingerprint(words []Word) string {
+	lines := make([]string, len(words))
+	for i, w := range words {
+		tags := append([]string(nil), w.Tags...)
+		sort.Strings(tags)
+		lines[i] = fmt.Sprintf("%s\x1f%s\x1f%d\x1f%g\x1f%s", w.Text, w.Category, w.Level, w.Weight, strings.Join(tags, ","))
+	}
+	sort.Strings(lines)
+
+	hash := sha256.New()
+	for _, line := range lines {
+		hash.Write([]byte(line))
+		hash.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
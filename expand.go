@@ -0,0 +1,175 @@
+package gosensitive
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Karrecy/sensitive-go/algorithm"
+	"github.com/Karrecy/sensitive-go/dict"
+	"github.com/Karrecy/sensitive-go/variant"
+)
+
+// minPinyinSpelling is the shortest all-Latin pinyin spelling compiled, such as
+// "shabi"; shorter ones like "anan" occur inside ordinary English words
+const minPinyinSpelling = 5
+
+// expandWords returns words followed by the derived forms enabled by the options
+// A derived form never shadows a dictionary word or an earlier derived form;
+// folded forms and skeletons come first, then full pinyin spellings, then initialisms
+func (o *Options) expandWords(words []dict.Word) []dict.Word {
+	if !o.EnableNormalize && o.similar == nil && !o.EnableConfusables && !o.EnablePinyin && !o.EnablePinyinInitials {
+		return words
+	}
+
+	key := func(text string) string {
+		if o.CaseSensitive {
+			return text
+		}
+		return strings.ToLower(text)
+	}
+
+	seen := make(map[string]bool, len(words))
+	for _, w := range words {
+		seen[key(w.Text)] = true
+	}
+
+	expanded := make([]dict.Word, len(words), len(words)*2)
+	copy(expanded, words)
+	derive := func(w dict.Word, forms []string, name string) {
+		for _, form := range forms {
+			if seen[key(form)] {
+				continue
+			}
+			seen[key(form)] = true
+
+			derived := w
+			derived.Text, derived.Base, derived.Variant = form, w.Text, name
+			expanded = append(expanded, derived)
+		}
+	}
+
+	// Text that went through folding, similar characters or confusables only
+	// matches words that did too
+	if o.EnableNormalize || o.similar != nil || o.EnableConfusables {
+		for _, w := range words {
+			text := w.Text
+			if o.EnableNormalize {
+				text = variant.Fold(text)
+				derive(w, []string{text}, "normalize")
+			}
+			if o.similar != nil {
+				text = o.similar.Process(text)
+				derive(w, []string{text}, "similar")
+			}
+			if o.EnableConfusables && o.CaseSensitive {
+				derive(w, []string{variant.Skeleton(text, o.ConfusablesCrossScriptOnly)}, "confusables")
+			} else if o.EnableConfusables {
+				derive(w, []string{variant.FoldedSkeleton(text, o.ConfusablesCrossScriptOnly)}, "confusables")
+			}
+		}
+	}
+
+	if o.EnablePinyin {
+		for _, w := range words {
+			// The readings of single characters, such as "e" or "se", are common Latin text
+			if utf8.RuneCountInString(w.Text) < 2 {
+				continue
+			}
+
+			forms := make([]string, 0, o.PinyinLimit)
+			for _, form := range variant.PinyinVariants(w.Text, o.PinyinLimit) {
+				if !isASCII(form) || len(form) >= minPinyinSpelling {
+					forms = append(forms, form)
+				}
+			}
+			derive(w, forms, "pinyin")
+		}
+	}
+
+	// Initialisms are opt-in per word, so an empty tag compiles none
+	if o.EnablePinyinInitials && o.PinyinInitialsTag != "" {
+		minLength := o.PinyinInitialsMinLength
+		if minLength < defaultPinyinInitialsMinLength {
+			minLength = defaultPinyinInitialsMinLength
+		}
+
+		for _, w := range words {
+			if !containsString(w.Tags, o.PinyinInitialsTag) {
+				continue
+			}
+
+			forms := make([]string, 0, 1)
+			for _, form := range variant.PinyinInitials(w.Text, o.PinyinLimit) {
+				if utf8.RuneCountInString(form) >= minLength {
+					forms = append(forms, form)
+				}
+			}
+
+			if o.PinyinInitialsLevel != nil {
+				w.Level, w.Weight = *o.PinyinInitialsLevel, 0
+			}
+			if o.PinyinInitialsCategory != nil {
+				w.Category = *o.PinyinInitialsCategory
+			}
+			derive(w, forms, "pinyin-initials")
+		}
+	}
+
+	return expanded
+}
+
+// isASCII reports whether s holds only ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// longestVariants drops a derived match when a longer match of the same word
+// starts at the same position, such as "傻b" inside "傻bi"
+func longestVariants(matches []algorithm.MatchResult) []algorithm.MatchResult {
+	type origin struct {
+		word  string
+		start int
+	}
+
+	var ends map[origin]int
+	for _, m := range matches {
+		if m.Variant == "" {
+			continue
+		}
+		if ends == nil {
+			ends = make(map[origin]int)
+		}
+		key := origin{m.Word, m.Start}
+		if m.End > ends[key] {
+			ends[key] = m.End
+		}
+	}
+	if ends == nil {
+		return matches
+	}
+
+	kept := make([]algorithm.MatchResult, 0, len(matches))
+	for _, m := range matches {
+		key := origin{m.Word, m.Start}
+		if end, exists := ends[key]; exists && m.End < end {
+			continue
+		}
+		kept = append(kept, m)
+	}
+	return kept
+}
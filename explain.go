@@ -0,0 +1,84 @@
+package gosensitive
+
+// Reasons a raw matcher hit is dropped
+const (
+	DropFilter        = "filter"          // A filter such as the whitelist rejected the word
+	DropCategory      = "category"        // The word is outside Options.Categories
+	DropLevel         = "level"           // The word is below Options.MinLevel
+	DropTags          = "tags"            // The word failed Options.IncludeTags or Options.ExcludeTags
+	DropMaxMatchCount = "max-match-count" // Options.MaxMatchCount was already reached
+)
+
+// Stage is the text after one variant processor
+type Stage struct {
+	Processor string `json:"processor"` // Name of the processor
+	Text      string `json:"text"`      // Text after the processor ran
+}
+
+// Hit is a raw matcher hit and what happened to it
+type Hit struct {
+	Match     Match  `json:"match"`                // The hit, located in the original text
+	Kept      bool   `json:"kept"`                 // Whether the hit is part of the result
+	DroppedBy string `json:"dropped_by,omitempty"` // Drop reason (one of the Drop constants), empty when kept
+	Detail    string `json:"detail,omitempty"`     // Human readable explanation of the drop
+}
+
+// Explanation traces how a text was processed, matched, filtered and decided
+type Explanation struct {
+	Text     string    `json:"text"`     // The original text
+	Stages   []Stage   `json:"stages"`   // Text after each variant processor, in order
+	Hits     []Hit     `json:"hits"`     // Every raw matcher hit, in matcher order
+	Result   *Result   `json:"result"`   // The result FindAll returns for the text
+	Decision *Decision `json:"decision"` // The decision Decide returns for the text
+}
+
+// Kept returns the hits that are part of the result
+func (e *Explanation) Kept() []Hit {
+	var kept []Hit
+	for _, hit := range e.Hits {
+		if hit.Kept {
+			kept = append(kept, hit)
+		}
+	}
+	return kept
+}
+
+// Dropped returns the hits that were dropped
+func (e *Explanation) Dropped() []Hit {
+	var dropped []Hit
+	for _, hit := range e.Hits {
+		if !hit.Kept {
+			dropped = append(dropped, hit)
+		}
+	}
+	return dropped
+}
+
+// Explain detects sensitive words like FindAll and Decide, and records every step:
+// the text after each variant processor, every raw matcher hit with the reason it
+// was dropped, the result and the final decision
+func (d *Detector) Explain(text string) *Explanation {
+	d.mu.RLock()
+
+	view := newTextView(text, d.processors)
+	raw := d.matcher.Match(view.processed)
+	matches, hits := d.trace(view, raw, true, d.options.MaxMatchCount)
+	result := d.newResult(view, raw, matches)
+	view.release()
+
+	stages := make([]Stage, len(d.processors))
+	for i, processor := range d.processors {
+		stages[i] = Stage{Processor: processor.Name(), Text: view.stages[i]}
+	}
+	rules := d.rules
+
+	d.mu.RUnlock()
+
+	return &Explanation{
+		Text:     text,
+		Stages:   stages,
+		Hits:     hits,
+		Result:   result,
+		Decision: d.decide(result, rules),
+	}
+}
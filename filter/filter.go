@@ -9,4 +9,10 @@ type Filter interface {
 	Name() string
 }
 
+// TagFilter is implemented by filters that also consider the tags of the matched word
+type TagFilter interface {
+	Filter
 
+	// ShouldFilterTagged returns true if the word, carrying the given tags, should be filtered out
+	ShouldFilterTagged(word string, tags []string) bool
+}
@@ -53,3 +53,38 @@ func TestWhitelist_Clear(t *testing.T) {
 }
 
 
+
+func TestWhitelist_AddTagged(t *testing.T) {
+	whitelist := NewWhitelist([]string{"测试"})
+	whitelist.AddTagged("博彩", "hk", "mo")
+
+	tests := []struct {
+		name     string
+		word     string
+		tags     []string
+		expected bool
+	}{
+		{"Unconditional entry", "测试", nil, true},
+		{"Scoped entry with matching tag", "博彩", []string{"cn", "mo"}, true},
+		{"Scoped entry without matching tag", "博彩", []string{"cn"}, false},
+		{"Scoped entry without tags", "博彩", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := whitelist.ShouldFilterTagged(tt.word, tt.tags)
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	if whitelist.ShouldFilter("博彩") {
+		t.Error("Scoped entry should not apply when tags are unknown")
+	}
+
+	whitelist.Remove("博彩")
+	if whitelist.ShouldFilterTagged("博彩", []string{"hk"}) {
+		t.Error("Failed to remove scoped entry")
+	}
+}
@@ -3,8 +3,10 @@ package filter
 import "strings"
 
 // Whitelist filters out words that are in the whitelist
+// Entries added with AddTagged only apply to words carrying one of their tags
 type Whitelist struct {
-	words map[string]bool
+	words  map[string]bool
+	scoped map[string][]string // Word -> tags the exemption is limited to
 }
 
 // NewWhitelist creates a new whitelist filter
@@ -15,15 +17,34 @@ func NewWhitelist(words []string) *Whitelist {
 	}
 
 	return &Whitelist{
-		words: wordMap,
+		words:  wordMap,
+		scoped: make(map[string][]string),
 	}
 }
 
 // ShouldFilter returns true if the word is in the whitelist
+// Tag-scoped entries are ignored because the tags of the word are unknown
 func (w *Whitelist) ShouldFilter(word string) bool {
 	return w.words[strings.ToLower(word)]
 }
 
+// ShouldFilterTagged returns true if the word is in the whitelist, either
+// unconditionally or under one of the given tags
+func (w *Whitelist) ShouldFilterTagged(word string, tags []string) bool {
+	key := strings.ToLower(word)
+	if w.words[key] {
+		return true
+	}
+	for _, scope := range w.scoped[key] {
+		for _, tag := range tags {
+			if tag == scope {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Name returns the filter name
 func (w *Whitelist) Name() string {
 	return "whitelist"
@@ -34,19 +55,51 @@ func (w *Whitelist) Add(word string) {
 	w.words[strings.ToLower(word)] = true
 }
 
-// Remove removes a word from the whitelist
+// AddTagged adds a word that is only exempt when the matched word carries one of the tags
+// Without tags the word is added unconditionally
+func (w *Whitelist) AddTagged(word string, tags ...string) {
+	if len(tags) == 0 {
+		w.Add(word)
+		return
+	}
+
+	key := strings.ToLower(word)
+	for _, tag := range tags {
+		if !containsString(w.scoped[key], tag) {
+			w.scoped[key] = append(w.scoped[key], tag)
+		}
+	}
+}
+
+// Remove removes a word from the whitelist, including its tag-scoped entries
 func (w *Whitelist) Remove(word string) {
-	delete(w.words, strings.ToLower(word))
+	key := strings.ToLower(word)
+	delete(w.words, key)
+	delete(w.scoped, key)
 }
 
-// Contains checks if a word is in the whitelist
+// Contains checks if a word is in the whitelist unconditionally
 func (w *Whitelist) Contains(word string) bool {
 	return w.words[strings.ToLower(word)]
 }
 
+// Tags returns the tags a word is exempt under (nil when it has no tag-scoped entries)
+func (w *Whitelist) Tags(word string) []string {
+	return w.scoped[strings.ToLower(word)]
+}
+
 // Clear removes all words from the whitelist
 func (w *Whitelist) Clear() {
 	w.words = make(map[string]bool)
+	w.scoped = make(map[string][]string)
 }
 
-
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package pool
+
+import "sync"
+
+// maxPooledCap is the largest slice capacity kept for reuse, so that one huge
+// text does not pin a large allocation in the pool
+const maxPooledCap = 1 << 16
+
+// intPool provides reusable int slices
+var intPool = sync.Pool{
+	New: func() interface{} {
+		s := make([]int, 0, 256)
+		return &s
+	},
+}
+
+// runePool provides reusable rune slices
+var runePool = sync.Pool{
+	New: func() interface{} {
+		s := make([]rune, 0, 256)
+		return &s
+	},
+}
+
+// GetInts retrieves an empty int slice with at least the given capacity
+func GetInts(capacity int) *[]int {
+	s := intPool.Get().(*[]int)
+	if cap(*s) < capacity {
+		*s = make([]int, 0, capacity)
+	}
+	*s = (*s)[:0]
+	return s
+}
+
+// PutInts returns an int slice to the pool
+func PutInts(s *[]int) {
+	if s == nil || cap(*s) > maxPooledCap {
+		return
+	}
+	intPool.Put(s)
+}
+
+// GetRunes retrieves an empty rune slice with at least the given capacity
+func GetRunes(capacity int) *[]rune {
+	s := runePool.Get().(*[]rune)
+	if cap(*s) < capacity {
+		*s = make([]rune, 0, capacity)
+	}
+	*s = (*s)[:0]
+	return s
+}
+
+// PutRunes returns a rune slice to the pool
+func PutRunes(s *[]rune) {
+	if s == nil || cap(*s) > maxPooledCap {
+		return
+	}
+	runePool.Put(s)
+}
@@ -0,0 +1,235 @@
+package loader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// CacheMeta describes a cached payload
+type CacheMeta struct {
+	Source      string    `json:"source"`                 // The source the payload was fetched from
+	FetchedAt   time.Time `json:"fetched_at"`             // When the payload was fetched
+	ContentType string    `json:"content_type,omitempty"` // Content type reported by the source
+	Size        int       `json:"size"`                   // Payload size in bytes
+	SHA256      string    `json:"sha256"`                 // Hex-encoded SHA-256 of the payload
+}
+
+// Age returns how long ago the payload was fetched
+func (m *CacheMeta) Age() time.Duration {
+	return time.Since(m.FetchedAt)
+}
+
+// DiskCache persists the last successful payload of remote sources in a local directory
+// Each entry is stored as a data file plus a JSON metadata file
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a disk cache rooted at dir; the directory is created on first save
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{dir: dir}
+}
+
+// Dir returns the cache directory
+func (c *DiskCache) Dir() string {
+	return c.dir
+}
+
+// Save stores the payload for key, replacing any previous entry atomically
+// The size and checksum fields of meta are filled in
+func (c *DiskCache) Save(key string, payload []byte, meta *CacheMeta) error {
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+	meta.Size = len(payload)
+	meta.SHA256 = hex.EncodeToString(sum[:])
+
+	metaData, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cache metadata: %w", err)
+	}
+
+	dataPath, metaPath := c.paths(key)
+	if err := writeFileAtomic(dataPath, payload); err != nil {
+		return err
+	}
+	return writeFileAtomic(metaPath, metaData)
+}
+
+// Load returns the cached payload for key and its metadata
+// The payload is verified against the checksum recorded in the metadata
+func (c *DiskCache) Load(key string) ([]byte, *CacheMeta, error) {
+	dataPath, metaPath := c.paths(key)
+
+	metaData, err := os.ReadFile(metaPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read cache metadata: %w", err)
+	}
+
+	var meta CacheMeta
+	if err := json.Unmarshal(metaData, &meta); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse cache metadata: %w", err)
+	}
+
+	payload, err := os.ReadFile(dataPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read cache data: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+	if hex.EncodeToString(sum[:]) != meta.SHA256 {
+		return nil, nil, fmt.Errorf("cache data for %s is corrupt", key)
+	}
+
+	return payload, &meta, nil
+}
+
+// paths returns the data and metadata file paths for key
+func (c *DiskCache) paths(key string) (string, string) {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:8])
+	return filepath.Join(c.dir, name+".data"), filepath.Join(c.dir, name+".json")
+}
+
+// writeFileAtomic writes data to a temporary file and renames it into place
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace cache file: %w", err)
+	}
+	return nil
+}
+
+// cacheState tracks whether a loader is serving a cached copy
+type cacheState struct {
+	stale   bool
+	meta    *CacheMeta
+	lastErr error
+	mu      sync.RWMutex
+}
+
+// set records the outcome of the latest load
+func (s *cacheState) set(stale bool, meta *CacheMeta, lastErr error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stale = stale
+	s.meta = meta
+	s.lastErr = lastErr
+}
+
+// Stale reports whether the latest fallback served the cached copy
+func (s *cacheState) Stale() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stale
+}
+
+// CacheMeta returns the metadata of the payload served by the latest load
+func (s *cacheState) CacheMeta() *CacheMeta {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.meta
+}
+
+// LastError returns the error of the latest load, which is nil after a successful
+// fetch and the fetch error while a cached copy is served
+func (s *cacheState) LastError() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastErr
+}
+
+// CachedLoader wraps any loader and persists its words to a disk cache
+// When the wrapped loader fails, Load serves the cached copy if there is one
+type CachedLoader struct {
+	cacheState
+	key    string
+	loader Loader
+	cache  *DiskCache
+}
+
+// NewCachedLoader creates a loader that caches the words of l under key
+func NewCachedLoader(key string, l Loader, cache *DiskCache) *CachedLoader {
+	return &CachedLoader{
+		key:    key,
+		loader: l,
+		cache:  cache,
+	}
+}
+
+// Load loads words from the wrapped loader and caches them on success
+// If the wrapped loader fails, the cached copy is served when there is one
+func (l *CachedLoader) Load() ([]dict.Word, error) {
+	words, err := l.loader.Load()
+	if err != nil {
+		if cached, meta, cacheErr := l.readCache(); cacheErr == nil {
+			l.set(true, meta, err)
+			return cached, nil
+		}
+		l.set(false, nil, err)
+		return nil, err
+	}
+
+	// A failure to persist the cache must not fail an otherwise good load
+	var meta *CacheMeta
+	if payload, encodeErr := json.Marshal(words); encodeErr == nil {
+		meta = &CacheMeta{Source: l.key, FetchedAt: time.Now(), ContentType: "application/json"}
+		if saveErr := l.cache.Save(l.key, payload, meta); saveErr != nil {
+			meta = nil
+		}
+	}
+
+	l.set(false, meta, nil)
+	return words, nil
+}
+
+// Fallback returns the cached words without calling the wrapped loader and
+// marks the loader stale
+func (l *CachedLoader) Fallback() ([]dict.Word, error) {
+	words, meta, err := l.readCache()
+	if err != nil {
+		return nil, err
+	}
+	l.set(true, meta, l.LastError())
+	return words, nil
+}
+
+// readCache decodes the cached words
+func (l *CachedLoader) readCache() ([]dict.Word, *CacheMeta, error) {
+	payload, meta, err := l.cache.Load(l.key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var words []dict.Word
+	if err := json.Unmarshal(payload, &words); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse cached words: %w", err)
+	}
+	return words, meta, nil
+}
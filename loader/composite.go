@@ -0,0 +1,266 @@
+package loader
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// MergePolicy decides how the same word coming from several sources is combined
+type MergePolicy int
+
+const (
+	// MergeUnion unions categories and tags and keeps the highest level
+	MergeUnion MergePolicy = iota
+	// MergeMaxLevel keeps the entry with the highest level
+	MergeMaxLevel
+	// MergeFirstWins keeps the entry from the highest-priority source
+	MergeFirstWins
+)
+
+// String returns the string representation of the merge policy
+func (p MergePolicy) String() string {
+	switch p {
+	case MergeUnion:
+		return "union"
+	case MergeMaxLevel:
+		return "max-level"
+	case MergeFirstWins:
+		return "first-wins"
+	default:
+		return "unknown"
+	}
+}
+
+// Source is a named loader with a priority
+// Sources with a higher priority are merged first; equal priorities keep insertion order
+type Source struct {
+	Name     string
+	Loader   Loader
+	Priority int
+}
+
+// SourceWords holds the words already loaded from one source
+type SourceWords struct {
+	Source   string
+	Priority int
+	Words    []dict.Word
+}
+
+// Conflict records a word that several entries define differently
+type Conflict struct {
+	Text    string      // The word text
+	Sources []string    // Source of each entry, in merge order
+	Entries []dict.Word // The conflicting entries, in merge order
+	Merged  dict.Word   // The entry that was kept
+}
+
+// String returns a human readable description of the conflict
+func (c Conflict) String() string {
+	parts := make([]string, len(c.Entries))
+	for i, entry := range c.Entries {
+		parts[i] = fmt.Sprintf("%s(%s/%s)", c.Sources[i], entry.Category, entry.Level)
+	}
+	return fmt.Sprintf("%q defined as %s, kept %s/%s",
+		c.Text, strings.Join(parts, ", "), c.Merged.Category, c.Merged.Level)
+}
+
+// MergeReport describes what a merge did
+type MergeReport struct {
+	Duplicates int        // Number of entries folded into an earlier entry
+	Conflicts  []Conflict // Words whose entries disagreed on category, level or tags
+}
+
+// Merge combines word sets from several sources into a de-duplicated list
+// When caseSensitive is false, words differing only in case are treated as the same word
+func Merge(sets []SourceWords, policy MergePolicy, caseSensitive bool) ([]dict.Word, *MergeReport) {
+	ordered := make([]SourceWords, len(sets))
+	copy(ordered, sets)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Priority > ordered[j].Priority
+	})
+
+	type entry struct {
+		merged  dict.Word
+		sources []string
+		entries []dict.Word
+		differs bool
+	}
+
+	report := &MergeReport{}
+	index := make(map[string]*entry)
+	order := make([]*entry, 0)
+
+	for _, set := range ordered {
+		for _, word := range set.Words {
+			if word.Source == "" {
+				word.Source = set.Source
+			}
+
+			key := word.Text
+			if !caseSensitive {
+				key = strings.ToLower(key)
+			}
+
+			e, exists := index[key]
+			if !exists {
+				e = &entry{merged: word}
+				e.merged.Tags = append([]string(nil), word.Tags...)
+				e.sources = []string{set.Source}
+				e.entries = []dict.Word{word}
+				index[key] = e
+				order = append(order, e)
+				continue
+			}
+
+			report.Duplicates++
+			if !sameDefinition(e.entries[0], word) {
+				e.differs = true
+			}
+			e.sources = append(e.sources, set.Source)
+			e.entries = append(e.entries, word)
+			e.merged = mergeWord(e.merged, word, policy)
+		}
+	}
+
+	words := make([]dict.Word, 0, len(order))
+	for _, e := range order {
+		words = append(words, e.merged)
+		if e.differs {
+			report.Conflicts = append(report.Conflicts, Conflict{
+				Text:    e.merged.Text,
+				Sources: e.sources,
+				Entries: e.entries,
+				Merged:  e.merged,
+			})
+		}
+	}
+
+	return words, report
+}
+
+// mergeWord folds next into kept according to the policy
+func mergeWord(kept, next dict.Word, policy MergePolicy) dict.Word {
+	switch policy {
+	case MergeUnion:
+		kept.Category = kept.Category.Add(next.Category)
+		if next.Level > kept.Level {
+			kept.Level = next.Level
+		}
+		if next.Weight > kept.Weight {
+			kept.Weight = next.Weight
+		}
+		for _, tag := range next.Tags {
+			if !containsTag(kept.Tags, tag) {
+				kept.Tags = append(kept.Tags, tag)
+			}
+		}
+	case MergeMaxLevel:
+		if next.Level > kept.Level {
+			next.Tags = append([]string(nil), next.Tags...)
+			kept = next
+		}
+	}
+	return kept
+}
+
+// sameDefinition reports whether two entries agree on category, level, weight and tags
+func sameDefinition(a, b dict.Word) bool {
+	if a.Category != b.Category || a.Level != b.Level || a.Weight != b.Weight || len(a.Tags) != len(b.Tags) {
+		return false
+	}
+	for _, tag := range a.Tags {
+		if !containsTag(b.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsTag checks if tags contains tag
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// CompositeLoader loads words from several prioritized sources and merges them
+type CompositeLoader struct {
+	sources       []Source
+	policy        MergePolicy
+	caseSensitive bool
+	errorMode     ErrorMode
+	report        *MergeReport
+	errors        []*SourceError
+	mu            sync.Mutex
+}
+
+// NewCompositeLoader creates a new composite loader with the given merge policy
+func NewCompositeLoader(policy MergePolicy) *CompositeLoader {
+	return &CompositeLoader{
+		sources:       make([]Source, 0),
+		policy:        policy,
+		caseSensitive: true,
+		errorMode:     ErrorStrict,
+		report:        &MergeReport{},
+	}
+}
+
+// Add adds a named source with the given priority
+func (l *CompositeLoader) Add(name string, loader Loader, priority int) *CompositeLoader {
+	l.sources = append(l.sources, Source{Name: name, Loader: loader, Priority: priority})
+	return l
+}
+
+// SetCaseSensitive sets whether words differing only in case are kept apart
+func (l *CompositeLoader) SetCaseSensitive(caseSensitive bool) *CompositeLoader {
+	l.caseSensitive = caseSensitive
+	return l
+}
+
+// SetErrorMode sets what happens when a source fails to load
+func (l *CompositeLoader) SetErrorMode(mode ErrorMode) *CompositeLoader {
+	l.errorMode = mode
+	return l
+}
+
+// Load loads all sources and merges them
+func (l *CompositeLoader) Load() ([]dict.Word, error) {
+	sets, failures, err := LoadSources(l.sources, l.errorMode)
+
+	l.mu.Lock()
+	l.errors = failures
+	l.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	words, report := Merge(sets, l.policy, l.caseSensitive)
+
+	l.mu.Lock()
+	l.report = report
+	l.mu.Unlock()
+
+	return words, nil
+}
+
+// Errors returns the sources that failed during the last Load
+func (l *CompositeLoader) Errors() []*SourceError {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.errors
+}
+
+// Report returns the merge report of the last successful Load
+func (l *CompositeLoader) Report() *MergeReport {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.report
+}
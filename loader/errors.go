@@ -0,0 +1,188 @@
+package loader
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// ErrorMode decides what happens when a source fails to load
+type ErrorMode int
+
+const (
+	// ErrorStrict fails the whole load if any source fails
+	ErrorStrict ErrorMode = iota
+	// ErrorLenient skips failing sources and reports them
+	ErrorLenient
+	// ErrorFallback serves the last-known-good copy of a failing source,
+	// skipping it when no copy is available
+	ErrorFallback
+)
+
+// String returns the string representation of the error mode
+func (m ErrorMode) String() string {
+	switch m {
+	case ErrorStrict:
+		return "strict"
+	case ErrorLenient:
+		return "lenient"
+	case ErrorFallback:
+		return "fallback"
+	default:
+		return "unknown"
+	}
+}
+
+// SourceError records a source that failed to load
+type SourceError struct {
+	Source   string // Name of the failing source
+	Err      error  // The load error
+	Fallback bool   // Whether a last-known-good copy was used instead
+}
+
+// Error implements the error interface
+func (e *SourceError) Error() string {
+	if e.Fallback {
+		return fmt.Sprintf("source %s: %v (using last-known-good copy)", e.Source, e.Err)
+	}
+	return fmt.Sprintf("source %s: %v", e.Source, e.Err)
+}
+
+// Unwrap returns the underlying load error
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError collects the errors of every failing source
+type MultiError struct {
+	Errors []*SourceError
+}
+
+// Error implements the error interface
+func (e *MultiError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+
+	parts := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		parts[i] = err.Error()
+	}
+	return fmt.Sprintf("%d sources failed to load: %s", len(e.Errors), strings.Join(parts, "; "))
+}
+
+// Unwrap returns the individual source errors for errors.Is and errors.As
+func (e *MultiError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Fallbacker is implemented by loaders that can serve a last-known-good copy
+type Fallbacker interface {
+	// Fallback returns the words of the last successful load
+	Fallback() ([]dict.Word, error)
+}
+
+// staleReporter is implemented by loaders that can serve a cached copy on their own
+type staleReporter interface {
+	Stale() bool
+	LastError() error
+}
+
+// LoadSources loads every source according to the error mode
+// It returns the loaded word sets and the errors of all failing sources, including
+// sources served from a cache; in strict mode a failure also yields a *MultiError and no word sets
+func LoadSources(sources []Source, mode ErrorMode) ([]SourceWords, []*SourceError, error) {
+	sets := make([]SourceWords, 0, len(sources))
+	var failures []*SourceError
+	failed := 0
+
+	for _, source := range sources {
+		words, err := source.Loader.Load()
+		if err == nil {
+			sets = append(sets, SourceWords{Source: source.Name, Priority: source.Priority, Words: words})
+
+			// Loaders with a disk cache may have served a stale copy themselves
+			if cached, ok := source.Loader.(staleReporter); ok && cached.Stale() {
+				failures = append(failures, &SourceError{Source: source.Name, Err: cached.LastError(), Fallback: true})
+			}
+			continue
+		}
+
+		failure := &SourceError{Source: source.Name, Err: err}
+		failures = append(failures, failure)
+		failed++
+
+		if mode != ErrorFallback {
+			continue
+		}
+
+		if fallbacker, ok := source.Loader.(Fallbacker); ok {
+			if cached, cacheErr := fallbacker.Fallback(); cacheErr == nil {
+				failure.Fallback = true
+				sets = append(sets, SourceWords{Source: source.Name, Priority: source.Priority, Words: cached})
+			}
+		}
+	}
+
+	if mode == ErrorStrict && failed > 0 {
+		return nil, failures, &MultiError{Errors: failures}
+	}
+
+	return sets, failures, nil
+}
+
+// lastGood remembers the words of the last successful load
+type lastGood struct {
+	words []dict.Word
+	ok    bool
+	mu    sync.RWMutex
+}
+
+// remember records the words of a successful load
+func (g *lastGood) remember(words []dict.Word) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.words = words
+	g.ok = true
+}
+
+// Fallback returns the words of the last successful load
+func (g *lastGood) Fallback() ([]dict.Word, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if !g.ok {
+		return nil, fmt.Errorf("no last-known-good copy available")
+	}
+	return g.words, nil
+}
+
+// LastGoodLoader wraps a loader and remembers its last successful result
+// so that it can be served as a fallback when the wrapped loader fails
+type LastGoodLoader struct {
+	lastGood
+	loader Loader
+}
+
+// NewLastGoodLoader creates a loader that remembers the last successful result of l
+func NewLastGoodLoader(l Loader) *LastGoodLoader {
+	return &LastGoodLoader{loader: l}
+}
+
+// Load loads words from the wrapped loader and remembers them on success
+func (l *LastGoodLoader) Load() ([]dict.Word, error) {
+	words, err := l.loader.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	l.remember(words)
+	return words, nil
+}
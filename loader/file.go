@@ -12,7 +12,10 @@ import (
 )
 
 // FileLoader loads sensitive words from a file
+// It remembers the words of its last successful load, which Fallback serves
+// when the file later goes missing or becomes unreadable
 type FileLoader struct {
+	lastGood
 	path string
 }
 
@@ -25,14 +28,22 @@ func NewFileLoader(path string) *FileLoader {
 func (l *FileLoader) Load() ([]dict.Word, error) {
 	ext := filepath.Ext(l.path)
 	
+	var words []dict.Word
+	var err error
 	switch strings.ToLower(ext) {
 	case ".json":
-		return l.loadJSON()
+		words, err = l.loadJSON()
 	case ".txt":
-		return l.loadTXT()
+		words, err = l.loadTXT()
 	default:
-		return l.loadTXT() // Default to txt format
+		words, err = l.loadTXT() // Default to txt format
+	}
+	if err != nil {
+		return nil, err
 	}
+
+	l.remember(words)
+	return words, nil
 }
 
 // loadTXT loads words from a plain text file (one word per line)
@@ -2,10 +2,12 @@ package loader
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -14,9 +16,11 @@ import (
 
 // HTTPLoader loads sensitive words from a remote HTTP(S) URL
 type HTTPLoader struct {
+	cacheState
 	url     string
 	timeout time.Duration
 	client  *http.Client
+	cache   *DiskCache
 }
 
 // NewHTTPLoader creates a new HTTP loader
@@ -37,26 +41,125 @@ func (l *HTTPLoader) SetTimeout(timeout time.Duration) *HTTPLoader {
 	return l
 }
 
+// SetCacheDir persists every successful payload under dir
+// When a fetch fails and a cached copy exists, Load serves that copy instead of
+// failing; use Stale, LastError and CacheMeta to find out whether that happened,
+// why, and how old the copy is
+func (l *HTTPLoader) SetCacheDir(dir string) *HTTPLoader {
+	l.cache = NewDiskCache(dir)
+	return l
+}
+
+// HasCache reports whether a cache directory is configured
+func (l *HTTPLoader) HasCache() bool {
+	return l.cache != nil
+}
+
 // Load downloads and loads words from the URL
+// If the download fails, the cached copy is served when there is one
 func (l *HTTPLoader) Load() ([]dict.Word, error) {
+	words, payload, contentType, err := l.fetch()
+	if err != nil {
+		if l.cache != nil {
+			if cached, meta, cacheErr := l.readCache(); cacheErr == nil {
+				l.set(true, meta, err)
+				return cached, nil
+			}
+		}
+		l.set(false, nil, err)
+		return nil, err
+	}
+
+	var meta *CacheMeta
+	if l.cache != nil {
+		// A failure to persist the cache must not fail an otherwise good load
+		meta = &CacheMeta{Source: redactURL(l.url), FetchedAt: time.Now(), ContentType: contentType}
+		if saveErr := l.cache.Save(l.url, payload, meta); saveErr != nil {
+			meta = nil
+		}
+	}
+	l.set(false, meta, nil)
+
+	return words, nil
+}
+
+// Fallback returns the cached words without contacting the server and marks
+// the loader stale
+func (l *HTTPLoader) Fallback() ([]dict.Word, error) {
+	if l.cache == nil {
+		return nil, fmt.Errorf("no cache directory configured")
+	}
+
+	words, meta, err := l.readCache()
+	if err != nil {
+		return nil, err
+	}
+	l.set(true, meta, l.LastError())
+	return words, nil
+}
+
+// redactURL drops the credentials, query and fragment of a URL, which may hold
+// access tokens, before it is written to the cache metadata
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "(invalid URL)"
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// fetch downloads and parses the payload
+func (l *HTTPLoader) fetch() ([]dict.Word, []byte, string, error) {
 	resp, err := l.client.Get(l.url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch URL: %w", err)
+		return nil, nil, "", fmt.Errorf("failed to fetch URL: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP request failed with status: %d", resp.StatusCode)
+		return nil, nil, "", fmt.Errorf("HTTP request failed with status: %d", resp.StatusCode)
+	}
+
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to read response: %w", err)
 	}
 
-	// Try to determine format from Content-Type
 	contentType := resp.Header.Get("Content-Type")
+	words, err := l.parse(contentType, payload)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return words, payload, contentType, nil
+}
+
+// readCache parses the cached payload
+func (l *HTTPLoader) readCache() ([]dict.Word, *CacheMeta, error) {
+	payload, meta, err := l.cache.Load(l.url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	words, err := l.parse(meta.ContentType, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	return words, meta, nil
+}
+
+// parse decodes a payload according to its content type
+func (l *HTTPLoader) parse(contentType string, payload []byte) ([]dict.Word, error) {
+	// Try to determine format from Content-Type
 	if strings.Contains(contentType, "application/json") {
-		return l.loadJSON(resp.Body)
+		return l.loadJSON(bytes.NewReader(payload))
 	}
 
 	// Default to plain text
-	return l.loadTXT(resp.Body)
+	return l.loadTXT(bytes.NewReader(payload))
 }
 
 // loadTXT loads words from plain text response
@@ -1,10 +1,22 @@
 package loader
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/Karrecy/sensitive-go/dict"
+	"github.com/Karrecy/sensitive-go/variant"
 )
 
 func TestMemoryLoader_Load(t *testing.T) {
@@ -93,3 +105,645 @@ func TestFileLoader_NonExistentFile(t *testing.T) {
 }
 
 
+
+// fakeTable is an in-memory table served by fakeDriver
+type fakeTable struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+// fakeTables holds the tables addressed by DSN
+var fakeTables = map[string]*fakeTable{}
+
+// fakeDriver is a minimal database/sql driver used to exercise SQLLoader
+// Queries without arguments return every row; queries with an argument
+// return rows whose last column is greater than it, and COUNT queries
+// return the number of rows
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	table, ok := fakeTables[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown table %q", name)
+	}
+	return &fakeConn{table: table}, nil
+}
+
+type fakeConn struct{ table *fakeTable }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{table: c.table, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, fmt.Errorf("not supported") }
+
+type fakeStmt struct {
+	table *fakeTable
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if strings.HasPrefix(s.query, "SELECT COUNT") {
+		return &fakeRows{columns: []string{"count"}, rows: [][]driver.Value{{int64(len(s.table.rows))}}}, nil
+	}
+
+	rows := make([][]driver.Value, 0, len(s.table.rows))
+	for _, row := range s.table.rows {
+		if len(args) > 0 && row[len(row)-1].(int64) <= args[0].(int64) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return &fakeRows{columns: s.table.columns, rows: rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func init() {
+	sql.Register("fakedict", fakeDriver{})
+}
+
+func TestSQLLoader_Load(t *testing.T) {
+	fakeTables["load"] = &fakeTable{
+		columns: []string{"word", "category", "level", "tags", "version"},
+		rows: [][]driver.Value{
+			{"敏感词", "political", int64(2), "cn,forum", int64(1)},
+			{"广告", []byte("ad|other"), "low", nil, int64(2)},
+		},
+	}
+
+	db, err := sql.Open("fakedict", "load")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	columns := DefaultSQLColumns()
+	columns.Text = "word"
+	loader := NewSQLLoader(db, "SELECT word, category, level, tags, version FROM words").SetColumns(columns)
+
+	result, err := loader.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 words, got %d", len(result))
+	}
+	if result[0].Category != dict.CategoryPolitical || result[0].Level != dict.LevelHigh {
+		t.Errorf("Unexpected first word: %+v", result[0])
+	}
+	if len(result[0].Tags) != 2 || result[0].Tags[1] != "forum" {
+		t.Errorf("Expected tags [cn forum], got %v", result[0].Tags)
+	}
+	if result[1].Category != dict.CategoryAd.Add(dict.CategoryOther) || result[1].Level != dict.LevelLow {
+		t.Errorf("Unexpected second word: %+v", result[1])
+	}
+}
+
+func TestSQLLoader_Incremental(t *testing.T) {
+	table := &fakeTable{
+		columns: []string{"text", "level", "deleted", "version"},
+		rows: [][]driver.Value{
+			{"敏感词", int64(1), false, int64(1)},
+			{"测试", int64(1), false, int64(2)},
+		},
+	}
+	fakeTables["incremental"] = table
+
+	db, err := sql.Open("fakedict", "incremental")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	columns := DefaultSQLColumns()
+	columns.Deleted = "deleted"
+	loader := NewSQLLoader(db, "SELECT * FROM words").
+		SetColumns(columns).
+		SetIncremental("version", "SELECT * FROM words WHERE version > ?")
+
+	if _, err := loader.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loader.LastVersion() != int64(2) {
+		t.Fatalf("Expected last version 2, got %v", loader.LastVersion())
+	}
+
+	// Nothing changed since the last poll
+	words, changed, err := loader.Poll()
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if changed || len(words) != 2 {
+		t.Errorf("Expected unchanged 2 words, got changed=%v len=%d", changed, len(words))
+	}
+
+	// Update one word, delete another and add a new one
+	table.rows = append(table.rows,
+		[]driver.Value{"敏感词", int64(3), false, int64(3)},
+		[]driver.Value{"测试", int64(1), true, int64(4)},
+		[]driver.Value{"新词", int64(0), false, int64(5)},
+	)
+
+	words, changed, err = loader.Poll()
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if !changed {
+		t.Error("Expected changes to be reported")
+	}
+	if len(words) != 2 {
+		t.Fatalf("Expected 2 words, got %d", len(words))
+	}
+	if words[0].Text != "敏感词" || words[0].Level != dict.LevelCritical {
+		t.Errorf("Expected updated word, got %+v", words[0])
+	}
+	if words[1].Text != "新词" {
+		t.Errorf("Expected new word, got %+v", words[1])
+	}
+}
+
+func TestSQLLoader_Key(t *testing.T) {
+	table := &fakeTable{
+		columns: []string{"id", "text", "level", "version"},
+		rows: [][]driver.Value{
+			{int64(1), "敏感词", int64(1), int64(1)},
+			{int64(2), "测试", int64(1), int64(2)},
+		},
+	}
+	fakeTables["key"] = table
+
+	db, err := sql.Open("fakedict", "key")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	columns := DefaultSQLColumns()
+	columns.Key = "id"
+	loader := NewSQLLoader(db, "SELECT * FROM words").
+		SetColumns(columns).
+		SetIncremental("version", "SELECT * FROM words WHERE version > ?").
+		SetCountQuery("SELECT COUNT(*) FROM words")
+
+	if _, err := loader.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	// Updating the text of a row replaces its old word
+	table.rows[0] = []driver.Value{int64(1), "敏感字", int64(1), int64(3)}
+	words, changed, err := loader.Poll()
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if !changed || len(words) != 2 || words[0].Text != "敏感字" || words[1].Text != "测试" {
+		t.Errorf("Expected [敏感字 测试], got changed=%v %+v", changed, words)
+	}
+
+	// A hard-deleted row drops the count and forces a full reload
+	table.rows = table.rows[:1]
+	words, changed, err = loader.Poll()
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if !changed || len(words) != 1 || words[0].Text != "敏感字" {
+		t.Errorf("Expected [敏感字], got changed=%v %+v", changed, words)
+	}
+
+	// Rows without a key are rejected
+	table.rows = append(table.rows, []driver.Value{nil, "新词", int64(1), int64(4)})
+	loader.Reset()
+	if _, err := loader.Load(); err == nil || !strings.Contains(err.Error(), "row 2") {
+		t.Errorf("Expected an error for the row without a key, got %v", err)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     interface{}
+		expected int
+	}{
+		{int64(10), int64(9), 1},
+		{[]byte("10"), "9", 1},
+		{"9", "10", -1},
+		{[]byte("1.5"), "1.25", 1},
+		{"2024-01-02 00:00:00", "2024-01-01 23:59:59", 1},
+		{"7", "7", 0},
+	}
+
+	for _, tt := range tests {
+		if result := compareVersions(tt.a, tt.b); result != tt.expected {
+			t.Errorf("compareVersions(%v, %v): expected %d, got %d", tt.a, tt.b, tt.expected, result)
+		}
+	}
+}
+
+func TestSQLLoader_InvalidLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		level driver.Value
+	}{
+		{"Negative", int64(-5)},
+		{"Negative text", "-1"},
+		{"Unknown name", "severe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeTables["level"] = &fakeTable{
+				columns: []string{"text", "severity"},
+				rows:    [][]driver.Value{{"测试", int64(1)}, {"敏感词", tt.level}},
+			}
+
+			db, err := sql.Open("fakedict", "level")
+			if err != nil {
+				t.Fatalf("Open failed: %v", err)
+			}
+			defer db.Close()
+
+			columns := DefaultSQLColumns()
+			columns.Level = "severity"
+			_, err = NewSQLLoader(db, "SELECT text, severity FROM words").SetColumns(columns).Load()
+			if err == nil {
+				t.Fatal("Expected error for invalid level, got nil")
+			}
+			if !strings.Contains(err.Error(), "row 2") || !strings.Contains(err.Error(), `"severity"`) {
+				t.Errorf("Expected the error to name the row and column, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSQLLoader_MissingTextColumn(t *testing.T) {
+	fakeTables["missing"] = &fakeTable{
+		columns: []string{"name"},
+		rows:    [][]driver.Value{{"敏感词"}},
+	}
+
+	db, err := sql.Open("fakedict", "missing")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := NewSQLLoader(db, "SELECT name FROM words").Load(); err == nil {
+		t.Error("Expected error for missing text column, got nil")
+	}
+}
+
+func TestMerge_Policies(t *testing.T) {
+	sets := []SourceWords{
+		{Source: "base", Priority: 0, Words: []dict.Word{
+			{Text: "敏感词", Category: dict.CategoryOther, Level: dict.LevelMedium, Tags: []string{"cn"}},
+			{Text: "测试", Category: dict.CategoryOther, Level: dict.LevelLow},
+		}},
+		{Source: "override", Priority: 10, Words: []dict.Word{
+			{Text: "敏感词", Category: dict.CategoryPolitical, Level: dict.LevelLow, Tags: []string{"forum"}},
+			{Text: "测试", Category: dict.CategoryOther, Level: dict.LevelLow},
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		policy   MergePolicy
+		category dict.Category
+		level    dict.Level
+		tags     int
+	}{
+		{"Union", MergeUnion, dict.CategoryPolitical.Add(dict.CategoryOther), dict.LevelMedium, 2},
+		{"Max level", MergeMaxLevel, dict.CategoryOther, dict.LevelMedium, 1},
+		{"First wins", MergeFirstWins, dict.CategoryPolitical, dict.LevelLow, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words, report := Merge(sets, tt.policy, true)
+			if len(words) != 2 {
+				t.Fatalf("Expected 2 words, got %d", len(words))
+			}
+
+			// The higher-priority source is merged first
+			if words[0].Text != "敏感词" {
+				t.Fatalf("Expected '敏感词' first, got %q", words[0].Text)
+			}
+			if words[0].Category != tt.category || words[0].Level != tt.level || len(words[0].Tags) != tt.tags {
+				t.Errorf("Unexpected merged word: %+v", words[0])
+			}
+
+			if report.Duplicates != 2 {
+				t.Errorf("Expected 2 duplicates, got %d", report.Duplicates)
+			}
+			if len(report.Conflicts) != 1 || report.Conflicts[0].Sources[0] != "override" {
+				t.Errorf("Expected one conflict led by 'override', got %+v", report.Conflicts)
+			}
+		})
+	}
+}
+
+func TestMerge_CaseInsensitive(t *testing.T) {
+	sets := []SourceWords{
+		{Source: "a", Words: []dict.Word{{Text: "Spam", Level: dict.LevelLow}}},
+		{Source: "b", Words: []dict.Word{{Text: "spam", Level: dict.LevelLow}}},
+	}
+
+	if words, _ := Merge(sets, MergeUnion, true); len(words) != 2 {
+		t.Errorf("Expected 2 case-sensitive words, got %d", len(words))
+	}
+	if words, _ := Merge(sets, MergeUnion, false); len(words) != 1 {
+		t.Errorf("Expected 1 case-insensitive word, got %d", len(words))
+	}
+}
+
+func TestCompositeLoader_Load(t *testing.T) {
+	composite := NewCompositeLoader(MergeFirstWins).
+		Add("memory", NewMemoryLoader([]string{"敏感词", "测试"}), 0).
+		Add("static", NewStaticLoader([]dict.Word{
+			{Text: "测试", Category: dict.CategoryAd, Level: dict.LevelHigh},
+		}), 1)
+
+	words, err := composite.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if len(words) != 2 {
+		t.Fatalf("Expected 2 words, got %d", len(words))
+	}
+	if words[0].Text != "测试" || words[0].Category != dict.CategoryAd {
+		t.Errorf("Expected the static entry to win, got %+v", words[0])
+	}
+	if len(composite.Report().Conflicts) != 1 {
+		t.Errorf("Expected 1 conflict, got %d", len(composite.Report().Conflicts))
+	}
+
+	composite.Add("broken", NewFileLoader("/nonexistent/file.txt"), 0)
+	if _, err := composite.Load(); err == nil {
+		t.Error("Expected error from failing source, got nil")
+	}
+}
+
+// flakyLoader fails once its fail flag is set
+type flakyLoader struct {
+	words []dict.Word
+	fail  bool
+}
+
+func (l *flakyLoader) Load() ([]dict.Word, error) {
+	if l.fail {
+		return nil, fmt.Errorf("source unavailable")
+	}
+	return l.words, nil
+}
+
+func TestLoadSources_ErrorModes(t *testing.T) {
+	flaky := &flakyLoader{words: []dict.Word{{Text: "敏感词"}}}
+	lastGood := NewLastGoodLoader(flaky)
+
+	// Prime the last-known-good copies
+	if _, err := lastGood.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	flaky.fail = true
+
+	path := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(path, []byte("违禁词\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write temp file: %v", err)
+	}
+	deleted := NewFileLoader(path)
+	if _, err := deleted.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("Failed to remove temp file: %v", err)
+	}
+
+	sources := []Source{
+		{Name: "memory", Loader: NewMemoryLoader([]string{"测试"})},
+		{Name: "missing", Loader: NewFileLoader("/nonexistent/file.txt")},
+		{Name: "deleted", Loader: deleted},
+		{Name: "remote", Loader: lastGood},
+	}
+
+	t.Run("Strict", func(t *testing.T) {
+		sets, failures, err := LoadSources(sources, ErrorStrict)
+		var multi *MultiError
+		if !errors.As(err, &multi) {
+			t.Fatalf("Expected *MultiError, got %v", err)
+		}
+		if len(multi.Errors) != 3 || multi.Errors[0].Source != "missing" || multi.Errors[2].Source != "remote" {
+			t.Errorf("Expected every failing source to be named, got %v", err)
+		}
+		if sets != nil || len(failures) != 3 {
+			t.Errorf("Expected no word sets and 3 failures, got %d sets and %d failures", len(sets), len(failures))
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Error("Expected the file error to be reachable through errors.Is")
+		}
+	})
+
+	t.Run("Lenient", func(t *testing.T) {
+		sets, failures, err := LoadSources(sources, ErrorLenient)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(sets) != 1 || len(failures) != 3 {
+			t.Errorf("Expected 1 set and 3 failures, got %d sets and %d failures", len(sets), len(failures))
+		}
+		for _, failure := range failures {
+			if failure.Fallback {
+				t.Errorf("Expected no fallback in lenient mode, got %v", failure)
+			}
+		}
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		sets, failures, err := LoadSources(sources, ErrorFallback)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(sets) != 3 || sets[1].Source != "deleted" || sets[1].Words[0].Text != "违禁词" {
+			t.Errorf("Expected the last good copy of 'deleted', got %+v", sets)
+		}
+		if len(sets) == 3 && (sets[2].Source != "remote" || sets[2].Words[0].Text != "敏感词") {
+			t.Errorf("Expected the cached copy of 'remote', got %+v", sets)
+		}
+		if len(failures) != 3 || failures[0].Fallback || !failures[1].Fallback || !failures[2].Fallback {
+			t.Errorf("Expected only the sources loaded before to fall back, got %v", failures)
+		}
+	})
+}
+
+func TestHTTPLoader_CacheFallback(t *testing.T) {
+	var unavailable atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unavailable.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Text": "敏感词", "Category": 1, "Level": 2}]`))
+	}))
+	defer server.Close()
+
+	cacheDir := t.TempDir()
+	url := server.URL + "/words.json?token=secret"
+	loader := NewHTTPLoader(url).SetCacheDir(cacheDir)
+
+	words, err := loader.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loader.Stale() {
+		t.Error("Fresh load should not be stale")
+	}
+
+	// A new loader sharing the cache directory boots while the server is down
+	unavailable.Store(true)
+	restarted := NewHTTPLoader(url).SetCacheDir(cacheDir)
+	sources := []Source{{Name: "remote", Loader: restarted}}
+
+	// Every error mode serves the cached copy and reports the source as stale
+	for _, mode := range []ErrorMode{ErrorStrict, ErrorLenient, ErrorFallback} {
+		sets, failures, err := LoadSources(sources, mode)
+		if err != nil || len(sets) != 1 {
+			t.Fatalf("Expected mode %v to serve the cached copy, got %v", mode, err)
+		}
+		cached := sets[0].Words
+		if len(cached) != len(words) || cached[0].Level != dict.LevelHigh {
+			t.Errorf("Expected cached words %+v, got %+v", words, cached)
+		}
+		if len(failures) != 1 || !failures[0].Fallback || failures[0].Err == nil {
+			t.Errorf("Expected the stale source to be reported, got %v", failures)
+		}
+	}
+	if !restarted.Stale() || restarted.LastError() == nil {
+		t.Error("Expected the loader to report a stale copy and the fetch error")
+	}
+	if meta := restarted.CacheMeta(); meta == nil || meta.Source != server.URL+"/words.json" || meta.Age() < 0 {
+		t.Errorf("Unexpected cache metadata: %+v", meta)
+	}
+
+	// The next successful fetch replaces the cached copy and clears the error
+	unavailable.Store(false)
+	if _, err := restarted.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if restarted.Stale() || restarted.LastError() != nil {
+		t.Error("Expected a fresh load to clear the stale flag and the error")
+	}
+	unavailable.Store(true)
+
+	// The query may carry credentials and never reaches the disk
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		t.Fatalf("Failed to read cache directory: %v", err)
+	}
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(cacheDir, entry.Name()))
+		if err != nil {
+			t.Fatalf("Failed to read cache file: %v", err)
+		}
+		if strings.Contains(string(data), "secret") {
+			t.Errorf("Cache file %s holds the URL query", entry.Name())
+		}
+	}
+
+	// Without a cache there is nothing to fall back to
+	uncached := NewHTTPLoader(url)
+	if _, err := uncached.Fallback(); err == nil {
+		t.Error("Expected error without cache, got nil")
+	}
+	if _, err := uncached.Load(); err == nil || uncached.LastError() == nil {
+		t.Fatalf("Expected the failed fetch to fail Load without a cache, got %v", err)
+	}
+	if _, _, err := LoadSources([]Source{{Name: "remote", Loader: uncached}}, ErrorStrict); err == nil {
+		t.Error("Expected strict mode to fail on a dead remote without a cache, got nil")
+	}
+	unavailable.Store(false)
+	if _, err := uncached.Load(); err != nil || uncached.LastError() != nil {
+		t.Errorf("Expected a successful fetch to clear the error, got %v and %v", err, uncached.LastError())
+	}
+}
+
+func TestCachedLoader_Fallback(t *testing.T) {
+	flaky := &flakyLoader{words: []dict.Word{{Text: "敏感词", Level: dict.LevelHigh}}}
+	cached := NewCachedLoader("flaky", flaky, NewDiskCache(t.TempDir()))
+
+	if _, err := cached.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	flaky.fail = true
+	words, err := cached.Load()
+	if err != nil {
+		t.Fatalf("Expected the cached copy to be served, got %v", err)
+	}
+	if len(words) != 1 || words[0].Text != "敏感词" || !cached.Stale() || cached.LastError() == nil {
+		t.Errorf("Expected stale cached words and the load error, got %+v", words)
+	}
+	if words, err = cached.Fallback(); err != nil || len(words) != 1 {
+		t.Errorf("Expected fallback to cache, got %v", err)
+	}
+
+	flaky.fail = false
+	if _, err := cached.Load(); err != nil || cached.Stale() || cached.LastError() != nil {
+		t.Errorf("Expected a fresh load to clear the stale flag and the error, got %v", err)
+	}
+
+	// Without a cached copy the error is returned
+	empty := NewCachedLoader("empty", &flakyLoader{fail: true}, NewDiskCache(t.TempDir()))
+	if _, err := empty.Load(); err == nil {
+		t.Error("Expected the failing loader to fail Load without a cache, got nil")
+	}
+}
+
+func TestFileLoader_LoadSimilar(t *testing.T) {
+	dir := t.TempDir()
+	txt := filepath.Join(dir, "similar.txt")
+	jsonPath := filepath.Join(dir, "similar.json")
+	if err := os.WriteFile(txt, []byte("# base similars...\no 0 〇\n\n傻 煞 儍\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write temp file: %v", err)
+	}
+	if err := os.WriteFile(jsonPath, []byte(`[{"base": "o", "similars": ["0", "〇"]}, {"base": "傻", "similars": ["煞", "儍"]}]`), 0o644); err != nil {
+		t.Fatalf("Failed to write temp file: %v", err)
+	}
+
+	expected := []variant.SimilarRule{{Base: 'o', Similars: []rune{'0', '〇'}}, {Base: '傻', Similars: []rune{'煞', '儍'}}}
+	for _, path := range []string{txt, jsonPath} {
+		rules, err := NewFileLoader(path).LoadSimilar()
+		if err != nil {
+			t.Fatalf("LoadSimilar(%s) failed: %v", path, err)
+		}
+		if !reflect.DeepEqual(rules, expected) {
+			t.Errorf("Expected %v, got %v", expected, rules)
+		}
+	}
+
+	invalid := []string{"o", "o 0x", "ab c"}
+	for _, line := range invalid {
+		if _, err := NewMemoryLoader([]string{line}).LoadSimilar(); err == nil {
+			t.Errorf("Expected an error for rule %q", line)
+		}
+	}
+}
@@ -32,3 +32,18 @@ func (l *MemoryLoader) Load() ([]dict.Word, error) {
 }
 
 
+
+// StaticLoader returns a fixed set of words with their metadata intact
+type StaticLoader struct {
+	words []dict.Word
+}
+
+// NewStaticLoader creates a new static loader
+func NewStaticLoader(words []dict.Word) *StaticLoader {
+	return &StaticLoader{words: words}
+}
+
+// Load returns the words the loader was created with
+func (l *StaticLoader) Load() ([]dict.Word, error) {
+	return l.words, nil
+}
@@ -0,0 +1,136 @@
+package loader
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Karrecy/sensitive-go/variant"
+)
+
+// SimilarLoader is the interface for loading similar character rules
+type SimilarLoader interface {
+	// LoadSimilar loads rules mapping look-alike characters to a base character
+	LoadSimilar() ([]variant.SimilarRule, error)
+}
+
+// similarJSON is the JSON form of a similar character rule
+type similarJSON struct {
+	Base     string   `json:"base"`
+	Similars []string `json:"similars"`
+}
+
+// LoadSimilar loads similar character rules from the file
+// Plain text files hold one rule per line: the base character followed by its
+// look-alikes, separated by whitespace; JSON files hold an array of
+// {"base": "鸡", "similars": ["鷄", "机"]} objects
+func (l *FileLoader) LoadSimilar() ([]variant.SimilarRule, error) {
+	file, err := os.Open(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	if strings.ToLower(filepath.Ext(l.path)) == ".json" {
+		return parseSimilarJSON(file)
+	}
+	return parseSimilarTXT(file)
+}
+
+// LoadSimilar parses every word of the loader as a plain text similar character rule
+func (l *MemoryLoader) LoadSimilar() ([]variant.SimilarRule, error) {
+	rules := make([]variant.SimilarRule, 0, len(l.words))
+	for _, line := range l.words {
+		rule, ok, err := parseSimilarLine(line)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// parseSimilarTXT parses plain text rules, skipping empty lines and comments
+func parseSimilarTXT(r io.Reader) ([]variant.SimilarRule, error) {
+	rules := make([]variant.SimilarRule, 0)
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		rule, ok, err := parseSimilarLine(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return rules, nil
+}
+
+// parseSimilarLine parses one plain text rule; ok is false for empty lines and comments
+func parseSimilarLine(line string) (variant.SimilarRule, bool, error) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return variant.SimilarRule{}, false, nil
+	}
+
+	fields := strings.Fields(line)
+	runes := make([]rune, len(fields))
+	for i, field := range fields {
+		r, err := singleRune(field)
+		if err != nil {
+			return variant.SimilarRule{}, false, err
+		}
+		runes[i] = r
+	}
+	if len(runes) < 2 {
+		return variant.SimilarRule{}, false, fmt.Errorf("similar rule %q has no similar characters", line)
+	}
+
+	return variant.SimilarRule{Base: runes[0], Similars: runes[1:]}, true, nil
+}
+
+// parseSimilarJSON parses an array of JSON rules
+func parseSimilarJSON(r io.Reader) ([]variant.SimilarRule, error) {
+	var entries []similarJSON
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	rules := make([]variant.SimilarRule, 0, len(entries))
+	for _, entry := range entries {
+		base, err := singleRune(entry.Base)
+		if err != nil {
+			return nil, err
+		}
+		rule := variant.SimilarRule{Base: base, Similars: make([]rune, len(entry.Similars))}
+		for i, similar := range entry.Similars {
+			if rule.Similars[i], err = singleRune(similar); err != nil {
+				return nil, err
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// singleRune returns the only character of s
+func singleRune(s string) (rune, error) {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 || size != len(s) || r == utf8.RuneError {
+		return 0, fmt.Errorf("similar rule entry %q is not a single character", s)
+	}
+	return r, nil
+}
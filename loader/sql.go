@@ -0,0 +1,427 @@
+package loader
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// SQLColumns maps result set columns onto dict.Word fields
+// An empty column name means the field is not present in the result set
+type SQLColumns struct {
+	Key      string // Column holding a stable row ID that tracks updates and deletions (defaults to the text)
+	Text     string // Column holding the word text (required)
+	Category string // Column holding a category name, name list or bitmask
+	Level    string // Column holding a level name or number
+	Tags     string // Column holding comma-separated tags
+	Weight   string // Column holding a numeric risk weight
+	Version  string // Column holding the updated_at timestamp or version number
+	Deleted  string // Column marking soft-deleted rows
+}
+
+// DefaultSQLColumns returns the column mapping used when none is configured
+func DefaultSQLColumns() SQLColumns {
+	return SQLColumns{
+		Text:     "text",
+		Category: "category",
+		Level:    "level",
+		Tags:     "tags",
+		Weight:   "weight",
+	}
+}
+
+// SQLLoader loads sensitive words from a relational table through database/sql
+// It does not import any driver; the caller opens the *sql.DB with the driver of its choice
+type SQLLoader struct {
+	db               *sql.DB
+	query            string
+	incrementalQuery string
+	countQuery       string
+	columns          SQLColumns
+	timeout          time.Duration
+
+	mu          sync.Mutex
+	loaded      bool
+	words       map[string]dict.Word
+	order       []string
+	lastVersion interface{}
+}
+
+// NewSQLLoader creates a new SQL loader that runs query to fetch the full word list
+func NewSQLLoader(db *sql.DB, query string) *SQLLoader {
+	return &SQLLoader{
+		db:      db,
+		query:   query,
+		columns: DefaultSQLColumns(),
+		timeout: 30 * time.Second,
+	}
+}
+
+// SetColumns sets the mapping from result set columns to word fields
+func (l *SQLLoader) SetColumns(columns SQLColumns) *SQLLoader {
+	l.columns = columns
+	return l
+}
+
+// SetIncremental enables incremental polling
+// versionColumn names the updated_at or version column, and query must select the
+// rows changed since the last seen version, which is passed as its only argument
+func (l *SQLLoader) SetIncremental(versionColumn, query string) *SQLLoader {
+	l.columns.Version = versionColumn
+	l.incrementalQuery = query
+	return l
+}
+
+// SetCountQuery sets a query that returns the number of live rows
+// Incremental polling cannot see hard-deleted rows, so before each incremental poll
+// the count is compared with the cached entries and a drop triggers a full reload
+func (l *SQLLoader) SetCountQuery(query string) *SQLLoader {
+	l.countQuery = query
+	return l
+}
+
+// SetTimeout sets the timeout for a single query
+func (l *SQLLoader) SetTimeout(timeout time.Duration) *SQLLoader {
+	l.timeout = timeout
+	return l
+}
+
+// Load returns the complete word list
+// The first call runs the full query; when incremental polling is enabled,
+// later calls only fetch rows changed since the last seen version
+func (l *SQLLoader) Load() ([]dict.Word, error) {
+	words, _, err := l.Poll()
+	return words, err
+}
+
+// Poll is like Load but also reports whether the word list changed since the previous call
+func (l *SQLLoader) Poll() ([]dict.Word, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	incremental := l.loaded && l.incrementalQuery != "" && l.lastVersion != nil
+	if incremental && l.countQuery != "" {
+		var count int64
+		if err := l.db.QueryRowContext(ctx, l.countQuery).Scan(&count); err != nil {
+			return nil, false, fmt.Errorf("failed to count words: %w", err)
+		}
+		// Rows were hard-deleted since the last poll
+		incremental = count >= int64(len(l.words))
+	}
+	if !incremental {
+		l.words = make(map[string]dict.Word)
+		l.order = l.order[:0]
+		l.lastVersion = nil
+	}
+
+	var rows *sql.Rows
+	var err error
+	if incremental {
+		rows, err = l.db.QueryContext(ctx, l.incrementalQuery, l.lastVersion)
+	} else {
+		rows, err = l.db.QueryContext(ctx, l.query)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to query words: %w", err)
+	}
+	defer rows.Close()
+
+	changed, err := l.scan(rows)
+	if err != nil {
+		// Force a full reload next time since the cached set may be partial
+		l.loaded = false
+		return nil, false, err
+	}
+
+	l.loaded = true
+	return l.snapshot(), changed || !incremental, nil
+}
+
+// Reset drops the cached word list so that the next Load runs the full query
+func (l *SQLLoader) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.loaded = false
+}
+
+// LastVersion returns the highest version value seen so far (nil before the first load)
+func (l *SQLLoader) LastVersion() interface{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.lastVersion
+}
+
+// scan reads all rows and merges them into the cached word set
+func (l *SQLLoader) scan(rows *sql.Rows) (bool, error) {
+	names, err := rows.Columns()
+	if err != nil {
+		return false, fmt.Errorf("failed to read columns: %w", err)
+	}
+
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[strings.ToLower(name)] = i
+	}
+
+	column := func(name string) int {
+		if name == "" {
+			return -1
+		}
+		if i, ok := index[strings.ToLower(name)]; ok {
+			return i
+		}
+		return -1
+	}
+
+	textCol := column(l.columns.Text)
+	if textCol < 0 {
+		return false, fmt.Errorf("text column %q not found in result set", l.columns.Text)
+	}
+	keyCol := column(l.columns.Key)
+	if l.columns.Key != "" && keyCol < 0 {
+		return false, fmt.Errorf("key column %q not found in result set", l.columns.Key)
+	}
+	categoryCol := column(l.columns.Category)
+	levelCol := column(l.columns.Level)
+	tagsCol := column(l.columns.Tags)
+	weightCol := column(l.columns.Weight)
+	versionCol := column(l.columns.Version)
+	deletedCol := column(l.columns.Deleted)
+
+	if l.columns.Version != "" && versionCol < 0 {
+		return false, fmt.Errorf("version column %q not found in result set", l.columns.Version)
+	}
+
+	values := make([]interface{}, len(names))
+	dest := make([]interface{}, len(names))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	changed := false
+	for row := 1; rows.Next(); row++ {
+		if err := rows.Scan(dest...); err != nil {
+			return false, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		text := strings.TrimSpace(sqlString(values[textCol]))
+		key := text
+		if keyCol >= 0 {
+			if key = strings.TrimSpace(sqlString(values[keyCol])); key == "" {
+				return false, fmt.Errorf("row %d, column %q, word %q: missing key", row, names[keyCol], text)
+			}
+		}
+		if key == "" {
+			continue
+		}
+
+		if versionCol >= 0 && values[versionCol] != nil {
+			if l.lastVersion == nil || compareVersions(values[versionCol], l.lastVersion) > 0 {
+				l.lastVersion = copyValue(values[versionCol])
+			}
+		}
+
+		changed = true
+
+		// A keyed row whose text was cleared is dropped like a deleted one
+		if text == "" || deletedCol >= 0 && sqlBool(values[deletedCol]) {
+			delete(l.words, key)
+			continue
+		}
+
+		word := dict.Word{
+			Text:     text,
+			Category: dict.CategoryOther,
+			Level:    dict.LevelMedium,
+		}
+
+		if categoryCol >= 0 && values[categoryCol] != nil {
+			category, err := sqlCategory(values[categoryCol])
+			if err != nil {
+				return false, fmt.Errorf("row %d, column %q, word %q: %w", row, names[categoryCol], text, err)
+			}
+			word.Category = category
+		}
+
+		if levelCol >= 0 && values[levelCol] != nil {
+			level, err := sqlLevel(values[levelCol])
+			if err != nil {
+				return false, fmt.Errorf("row %d, column %q, word %q: %w", row, names[levelCol], text, err)
+			}
+			word.Level = level
+		}
+
+		if tagsCol >= 0 {
+			word.Tags = splitTags(sqlString(values[tagsCol]))
+		}
+
+		if weightCol >= 0 && values[weightCol] != nil {
+			weight, err := sqlWeight(values[weightCol])
+			if err != nil {
+				return false, fmt.Errorf("row %d, column %q, word %q: %w", row, names[weightCol], text, err)
+			}
+			word.Weight = weight
+		}
+
+		if _, exists := l.words[key]; !exists {
+			l.order = append(l.order, key)
+		}
+		l.words[key] = word
+	}
+
+	if err := rows.Err(); err != nil {
+		return false, fmt.Errorf("failed to read rows: %w", err)
+	}
+
+	return changed, nil
+}
+
+// snapshot returns the cached words in first-seen order, compacting removed entries
+func (l *SQLLoader) snapshot() []dict.Word {
+	words := make([]dict.Word, 0, len(l.words))
+	order := l.order[:0]
+
+	for _, key := range l.order {
+		if word, exists := l.words[key]; exists {
+			words = append(words, word)
+			order = append(order, key)
+		}
+	}
+	l.order = order
+
+	return words
+}
+
+// sqlString converts a scanned value to a string
+func sqlString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// sqlBool interprets a scanned value as a boolean flag
+func sqlBool(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return val
+	case int64:
+		return val != 0
+	case float64:
+		return val != 0
+	default:
+		b, err := strconv.ParseBool(strings.TrimSpace(sqlString(val)))
+		return err == nil && b
+	}
+}
+
+// sqlCategory converts a scanned value to a category
+func sqlCategory(v interface{}) (dict.Category, error) {
+	if n, ok := v.(int64); ok {
+		if n < 0 {
+			return dict.Category{}, fmt.Errorf("invalid category bitmask: %d", n)
+		}
+		return dict.CategoryFromMask(uint64(n)), nil
+	}
+	return dict.ParseCategory(sqlString(v))
+}
+
+// sqlWeight converts a scanned value to a weight
+func sqlWeight(v interface{}) (float64, error) {
+	switch val := v.(type) {
+	case int64:
+		return float64(val), nil
+	case float64:
+		return val, nil
+	}
+	weight, err := strconv.ParseFloat(strings.TrimSpace(sqlString(v)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid weight: %q", sqlString(v))
+	}
+	return weight, nil
+}
+
+// sqlLevel converts a scanned value to a level
+func sqlLevel(v interface{}) (dict.Level, error) {
+	if n, ok := v.(int64); ok {
+		if level := dict.Level(n); level.Valid() {
+			return level, nil
+		}
+		return 0, fmt.Errorf("level %d is negative", n)
+	}
+	return dict.ParseLevel(sqlString(v))
+}
+
+// splitTags splits a comma-separated tag list
+func splitTags(s string) []string {
+	var tags []string
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// copyValue detaches byte slices from the driver's buffers
+func copyValue(v interface{}) interface{} {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
+}
+
+// compareVersions compares two version values of the same kind
+// It returns a negative number, zero or a positive number like strings.Compare
+func compareVersions(a, b interface{}) int {
+	switch x := a.(type) {
+	case int64:
+		if y, ok := b.(int64); ok {
+			return cmp.Compare(x, y)
+		}
+	case float64:
+		if y, ok := b.(float64); ok {
+			return cmp.Compare(x, y)
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y)
+		}
+	}
+
+	// Drivers often return numeric columns as text, which must not compare as "10" < "9"
+	x, y := strings.TrimSpace(sqlString(a)), strings.TrimSpace(sqlString(b))
+	if m, err := strconv.ParseInt(x, 10, 64); err == nil {
+		if n, err := strconv.ParseInt(y, 10, 64); err == nil {
+			return cmp.Compare(m, n)
+		}
+	}
+	if m, err := strconv.ParseFloat(x, 64); err == nil {
+		if n, err := strconv.ParseFloat(y, 64); err == nil {
+			return cmp.Compare(m, n)
+		}
+	}
+	return strings.Compare(x, y)
+}
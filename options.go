@@ -1,6 +1,13 @@
 package gosensitive
 
-import "time"
+import (
+	"time"
+
+	"github.com/Karrecy/sensitive-go/algorithm"
+	"github.com/Karrecy/sensitive-go/dict"
+	"github.com/Karrecy/sensitive-go/loader"
+	"github.com/Karrecy/sensitive-go/variant"
+)
 
 // Options contains configuration options for the detector
 type Options struct {
@@ -10,18 +17,82 @@ type Options struct {
 	// CaseSensitive determines if matching should be case-sensitive
 	CaseSensitive bool
 
-	// EnablePinyin enables pinyin variant detection
+	// EnablePinyin enables pinyin variant detection by adding the pinyin
+	// spellings of every dictionary word of two or more characters to the matcher;
+	// all-Latin spellings shorter than 5 letters are skipped as common English
+	// Each word adds up to PinyinLimit words to the matcher: the built-in dictionary
+	// grows from about 130k to 1.1M words and its build from about 0.4s to 9s
+	// and 2.6 GB allocated (see BenchmarkBuild_Pinyin)
 	EnablePinyin bool
 
+	// PinyinLimit is the maximum number of pinyin spellings per word (0 means variant.DefaultPinyinLimit)
+	// It bounds the cost of EnablePinyin; lower it for large dictionaries
+	PinyinLimit int
+
+	// EnablePinyinInitials adds the pinyin initialisms of dictionary words, such as "sb"
+	EnablePinyinInitials bool
+
+	// PinyinInitialsMinLength is the shortest initialism compiled, in runes
+	// Values below 2 use 2, since single-letter initialisms match almost any text
+	PinyinInitialsMinLength int
+
+	// PinyinInitialsTag limits initialisms to words carrying this tag (empty means no word)
+	PinyinInitialsTag string
+
+	// PinyinInitialsLevel replaces the level of words matched by their initialisms (nil keeps it)
+	// The weight of the level then applies instead of the word's own weight
+	PinyinInitialsLevel *Level
+
+	// PinyinInitialsCategory replaces the category of words matched by their initialisms (nil keeps it)
+	PinyinInitialsCategory *Category
+
 	// EnableTraditional enables traditional Chinese variant detection
 	EnableTraditional bool
 
+	// Conversion is how traditional detection normalises text (default variant.ConvertT2S)
+	Conversion variant.Conversion
+
+	// EnableInvisible removes zero-width, format and other invisible characters before matching
+	EnableInvisible bool
+
+	// EnableNormalize folds compatibility characters, diacritics and case before matching
+	EnableNormalize bool
+
+	// EnableConfusables matches homoglyphs by comparing Unicode confusables skeletons (UTS #39)
+	EnableConfusables bool
+
+	// ConfusablesCrossScriptOnly limits confusables to characters imitating another script
+	ConfusablesCrossScriptOnly bool
+
+	// EnableSubstitution lets dictionary characters match leetspeak and other
+	// substitutions, such as "b4dw0rd" for "badword"
+	EnableSubstitution bool
+
+	// Substitutions maps dictionary characters to the input sequences they accept
+	// (nil means algorithm.DefaultSubstitutions())
+	Substitutions algorithm.Substitutions
+
+	// SubstitutionLimit is the maximum number of spellings compiled per word (0 means algorithm.DefaultSubstitutionLimit)
+	SubstitutionLimit int
+
+	// EnableRepeatCollapse lets runs of one character match a single occurrence, such as "baaaad" for "bad"
+	EnableRepeatCollapse bool
+
+	// MaxRepeatRun is the longest run collapsed (0 means algorithm.DefaultMaxRun)
+	MaxRepeatRun int
+
 	// EnableSymbolFilter enables filtering of symbol interference
 	EnableSymbolFilter bool
 
 	// EnableSimilarChar enables similar character detection
 	EnableSimilarChar bool
 
+	// SimilarRules are custom similar character rules added to the built-in ones
+	SimilarRules []variant.SimilarRule
+
+	// similar is the processor built from the built-in rules, SimilarRules and the rule loaders
+	similar *variant.SimilarProcessor
+
 	// ReplaceChar is the default character used for replacement
 	ReplaceChar rune
 
@@ -31,13 +102,52 @@ type Options struct {
 	// MinLevel is the minimum severity level to detect
 	MinLevel Level
 
+	// IncludeTags limits detection to words carrying any of these tags (nil means all)
+	IncludeTags []string
+
+	// ExcludeTags drops words carrying any of these tags
+	ExcludeTags []string
+
 	// MaxMatchCount limits the maximum number of matches to return (0 means no limit)
 	MaxMatchCount int
 
+	// LevelWeights is the risk weight of words that have no weight of their own
+	LevelWeights map[Level]float64
+
+	// ScorePolicy decides how match weights are combined into Result.Score
+	ScorePolicy ScorePolicy
+
+	// ScoreDecay is the factor applied per match under ScoreDecayed (default 0.5)
+	ScoreDecay float64
+
+	// ReviewThreshold is the score at which Result.Verdict becomes VerdictReview (0 disables)
+	// The default of 6 takes one high or two medium hits, so a single medium hit is only masked
+	ReviewThreshold float64
+
+	// BlockThreshold is the score at which Result.Verdict becomes VerdictBlock (0 disables)
+	BlockThreshold float64
+
+	// MergePolicy decides how the same word from several sources is combined
+	MergePolicy loader.MergePolicy
+
+	// LoadErrorMode decides what happens when a blacklist or whitelist source fails to load
+	LoadErrorMode loader.ErrorMode
+
+	// CacheDir persists remote dictionaries so that they survive an outage (empty disables caching)
+	CacheDir string
+
+	// MaxVersions is the number of dictionary versions kept for rollback (including the current one)
+	// Every retained version keeps its whole matcher alive, so memory grows linearly with it
+	// and the default of 1 keeps only the live dictionary
+	MaxVersions int
+
 	// WatchFile enables automatic reloading when word files change
 	WatchFile bool
 
-	// WatchInterval is the interval for checking file changes
+	// WatchSQL enables polling SQL sources and refreshing the detector when their rows change
+	WatchSQL bool
+
+	// WatchInterval is the interval for checking file changes and polling SQL sources
 	WatchInterval time.Duration
 }
 
@@ -53,56 +163,74 @@ const (
 	AlgorithmAC
 )
 
-// Category represents the category of sensitive words using bit flags
-type Category int
+// Category represents a set of sensitive word categories
+// Custom categories are registered with dict.RegisterCategory
+type Category = dict.Category
 
-const (
+var (
 	// CategoryPolitical represents political sensitive words
-	CategoryPolitical Category = 1 << iota
+	CategoryPolitical = dict.CategoryPolitical
 	// CategoryPornographic represents pornographic content
-	CategoryPornographic
+	CategoryPornographic = dict.CategoryPornographic
 	// CategoryViolence represents violence and gore
-	CategoryViolence
+	CategoryViolence = dict.CategoryViolence
 	// CategoryAbuse represents abusive and insulting words
-	CategoryAbuse
+	CategoryAbuse = dict.CategoryAbuse
 	// CategoryAd represents advertisement and spam
-	CategoryAd
+	CategoryAd = dict.CategoryAd
 	// CategoryIllegal represents illegal activities
-	CategoryIllegal
+	CategoryIllegal = dict.CategoryIllegal
 	// CategoryOther represents other categories
-	CategoryOther
+	CategoryOther = dict.CategoryOther
 )
 
 // Level represents the severity level of a sensitive word
-type Level int
+type Level = dict.Level
 
 const (
 	// LevelLow indicates a low severity word
-	LevelLow Level = iota
+	LevelLow = dict.LevelLow
 	// LevelMedium indicates a medium severity word
-	LevelMedium
+	LevelMedium = dict.LevelMedium
 	// LevelHigh indicates a high severity word
-	LevelHigh
+	LevelHigh = dict.LevelHigh
 	// LevelCritical indicates a critical severity word
-	LevelCritical
+	LevelCritical = dict.LevelCritical
 )
 
+// defaultPinyinInitialsMinLength is the shortest pinyin initialism compiled by default and at all
+const defaultPinyinInitialsMinLength = 2
+
 // DefaultOptions returns the default options
 func DefaultOptions() *Options {
 	return &Options{
-		Algorithm:          AlgorithmAuto,
-		CaseSensitive:      false,
-		EnablePinyin:       false,
-		EnableTraditional:  false,
-		EnableSymbolFilter: false,
-		EnableSimilarChar:  false,
-		ReplaceChar:        '*',
-		Categories:         nil,
-		MinLevel:           LevelLow,
-		MaxMatchCount:      0,
-		WatchFile:          false,
-		WatchInterval:      time.Second * 30,
+		Algorithm:               AlgorithmAuto,
+		CaseSensitive:           false,
+		EnablePinyin:            false,
+		PinyinInitialsMinLength: defaultPinyinInitialsMinLength,
+		EnableTraditional:       false,
+		Conversion:              variant.ConvertT2S,
+		EnableInvisible:         false,
+		EnableNormalize:         false,
+		EnableConfusables:       false,
+		EnableSubstitution:      false,
+		EnableRepeatCollapse:    false,
+		EnableSymbolFilter:      false,
+		EnableSimilarChar:       false,
+		ReplaceChar:             '*',
+		Categories:              nil,
+		MinLevel:                LevelLow,
+		MaxMatchCount:           0,
+		LevelWeights:            DefaultLevelWeights(),
+		ScorePolicy:             ScoreSum,
+		ScoreDecay:              0.5,
+		ReviewThreshold:         6,
+		BlockThreshold:          10,
+		MergePolicy:             loader.MergeUnion,
+		LoadErrorMode:           loader.ErrorStrict,
+		MaxVersions:             1,
+		WatchFile:               false,
+		WatchSQL:                false,
+		WatchInterval:           time.Second * 30,
 	}
 }
-
-
@@ -3,19 +3,51 @@ package gosensitive
 import "github.com/Karrecy/sensitive-go/dict"
 
 // Result represents the detection result
+// Its JSON encoding is stable; see docs/wire-schema.md
 type Result struct {
-	Found        bool    // Whether any sensitive words were found
-	Matches      []Match // List of all matches
-	FilteredText string  // Text with sensitive words filtered/replaced
+	Found        bool    `json:"found"`         // Whether any sensitive words were found
+	Matches      []Match `json:"matches"`       // List of all matches
+	FilteredText string  `json:"filtered_text"` // Text with sensitive words filtered/replaced
+	Score        float64 `json:"score"`         // Aggregate risk score of the matches
+	Verdict      Verdict `json:"verdict"`       // Action suggested by the score
 }
 
 // Match represents a single sensitive word match
 type Match struct {
-	Word     string        // The matched sensitive word
-	Start    int           // Start position in runes (not bytes)
-	End      int           // End position in runes (not bytes)
-	Category dict.Category // Category of the matched word
-	Level    dict.Level    // Severity level of the matched word
+	Word      string        `json:"word"`               // The matched sensitive word
+	Text      string        `json:"text"`               // The original text that matched, before case folding and variant processing
+	Start     int           `json:"start"`              // Start position in runes (not bytes) of the original text
+	End       int           `json:"end"`                // End position in runes (not bytes) of the original text
+	ByteStart int           `json:"byte_start"`         // Start position in bytes, so that text[ByteStart:ByteEnd] == Text
+	ByteEnd   int           `json:"byte_end"`           // End position in bytes
+	Line      int           `json:"line"`               // 1-based line of the match start
+	Column    int           `json:"column"`             // 1-based column in runes of the match start
+	Category  dict.Category `json:"category"`           // Category of the matched word
+	Level     dict.Level    `json:"level"`              // Severity level of the matched word
+	Weight    float64       `json:"weight"`             // Risk weight of the matched word
+	Tags      []string      `json:"tags,omitempty"`     // Tags of the matched word, shared with the dictionary (do not modify)
+	Source    string        `json:"source,omitempty"`   // Name of the source the word was loaded from
+	Variants  []string      `json:"variants,omitempty"` // Names of the variant processors that rewrote the matched text
+}
+
+// HasTag checks if the match carries the specified tag
+func (m Match) HasTag(tag string) bool {
+	for _, t := range m.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnyTag checks if the match carries any of the specified tags
+func (m Match) hasAnyTag(tags []string) bool {
+	for _, tag := range tags {
+		if m.HasTag(tag) {
+			return true
+		}
+	}
+	return false
 }
 
 // HasCategory checks if the result contains matches of the specified category
@@ -60,4 +92,23 @@ func (r *Result) FilterByLevel(level dict.Level) []Match {
 	return filtered
 }
 
+// HasTag checks if the result contains matches carrying the specified tag
+func (r *Result) HasTag(tag string) bool {
+	for _, match := range r.Matches {
+		if match.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
 
+// FilterByTag returns matches that carry the specified tag
+func (r *Result) FilterByTag(tag string) []Match {
+	var filtered []Match
+	for _, match := range r.Matches {
+		if match.HasTag(tag) {
+			filtered = append(filtered, match)
+		}
+	}
+	return filtered
+}
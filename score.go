@@ -0,0 +1,166 @@
+package gosensitive
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// ScorePolicy decides how the weights of the matches in a text are combined into a risk score
+type ScorePolicy int
+
+const (
+	// ScoreSum adds the weights of all matches
+	ScoreSum ScorePolicy = iota
+	// ScoreMax uses the highest weight of any match
+	ScoreMax
+	// ScoreDecayed adds the weights in text order, multiplying the n-th match
+	// (counting from 0) by ScoreDecay^n so that repeated hits count less
+	ScoreDecayed
+)
+
+// String returns the string representation of the score policy
+func (p ScorePolicy) String() string {
+	switch p {
+	case ScoreSum:
+		return "sum"
+	case ScoreMax:
+		return "max"
+	case ScoreDecayed:
+		return "decayed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the score policy by name
+func (p ScorePolicy) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes a score policy name
+func (p *ScorePolicy) UnmarshalText(text []byte) error {
+	for _, policy := range []ScorePolicy{ScoreSum, ScoreMax, ScoreDecayed} {
+		if policy.String() == strings.ToLower(strings.TrimSpace(string(text))) {
+			*p = policy
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown score policy: %q", text)
+}
+
+// Verdict is the action suggested by a risk score
+type Verdict int
+
+const (
+	// VerdictAllow means the score is below the review threshold
+	VerdictAllow Verdict = iota
+	// VerdictReview means the score reached the review threshold
+	VerdictReview
+	// VerdictBlock means the score reached the block threshold
+	VerdictBlock
+)
+
+// String returns the string representation of the verdict
+func (v Verdict) String() string {
+	switch v {
+	case VerdictAllow:
+		return "allow"
+	case VerdictReview:
+		return "review"
+	case VerdictBlock:
+		return "block"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the verdict by name
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText decodes a verdict name
+func (v *Verdict) UnmarshalText(text []byte) error {
+	for _, verdict := range []Verdict{VerdictAllow, VerdictReview, VerdictBlock} {
+		if verdict.String() == strings.ToLower(strings.TrimSpace(string(text))) {
+			*v = verdict
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown verdict: %q", text)
+}
+
+// DefaultLevelWeights returns the weight of each built-in level
+// Words with a non-zero Weight use their own weight instead
+func DefaultLevelWeights() map[Level]float64 {
+	return map[Level]float64{
+		LevelLow:      1,
+		LevelMedium:   3,
+		LevelHigh:     6,
+		LevelCritical: 10,
+	}
+}
+
+// weightOf returns the effective weight of a word
+// Levels missing from Options.LevelWeights weigh their numeric value plus one
+func (o *Options) weightOf(level dict.Level, weight float64) float64 {
+	if weight != 0 {
+		return weight
+	}
+	if w, exists := o.LevelWeights[level]; exists {
+		return w
+	}
+	return float64(level) + 1
+}
+
+// Score combines the weights of the matches according to the score policy
+func (o *Options) Score(matches []Match) float64 {
+	switch o.ScorePolicy {
+	case ScoreMax:
+		score := 0.0
+		for _, m := range matches {
+			score = math.Max(score, m.Weight)
+		}
+		return score
+	case ScoreDecayed:
+		ordered := make([]Match, len(matches))
+		copy(ordered, matches)
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].Start < ordered[j].Start
+		})
+
+		decay := o.ScoreDecay
+		if decay <= 0 || decay > 1 {
+			decay = 0.5
+		}
+
+		score, factor := 0.0, 1.0
+		for _, m := range ordered {
+			score += m.Weight * factor
+			factor *= decay
+		}
+		return score
+	default:
+		score := 0.0
+		for _, m := range matches {
+			score += m.Weight
+		}
+		return score
+	}
+}
+
+// Verdict maps a risk score onto the review and block thresholds
+// A threshold of 0 or less is disabled
+func (o *Options) Verdict(score float64) Verdict {
+	if o.BlockThreshold > 0 && score >= o.BlockThreshold {
+		return VerdictBlock
+	}
+	if o.ReviewThreshold > 0 && score >= o.ReviewThreshold {
+		return VerdictReview
+	}
+	return VerdictAllow
+}
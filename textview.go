@@ -0,0 +1,204 @@
+package gosensitive
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"github.com/Karrecy/sensitive-go/internal/pool"
+	"github.com/Karrecy/sensitive-go/variant"
+)
+
+// textView is a preprocessed text together with the mapping back to the original text
+type textView struct {
+	original  string
+	processed string
+	origin    []int    // Processed rune index -> original rune index (nil means identity)
+	changed   []uint64 // Processed rune index -> processors that rewrote the rune
+	gaps      []uint64 // Processed rune index -> processors that dropped runes right before it
+	bytes     []int    // Original rune index -> byte offset, with a final entry for len(original)
+	pooled    *[]int   // Pooled backing slice of bytes, returned by release
+	lines     []int    // Rune indexes of line starts, built on first use
+	masking   bool     // Whether matches are located only to be masked, skipping line and column
+	stages    []string // Text after each processor
+}
+
+// newTextView runs the processors over text, tracking where every output rune came from
+func newTextView(text string, processors []variant.Processor) *textView {
+	view := &textView{original: text, processed: text}
+
+	view.pooled = pool.GetInts(len(text) + 1)
+	view.bytes = *view.pooled
+	for i := range text {
+		view.bytes = append(view.bytes, i)
+	}
+	view.bytes = append(view.bytes, len(text))
+	*view.pooled = view.bytes
+
+	if len(processors) == 0 {
+		return view
+	}
+
+	current := []rune(text)
+	view.origin = make([]int, len(current))
+	for i := range view.origin {
+		view.origin[i] = i
+	}
+	view.changed = make([]uint64, len(current))
+	view.gaps = make([]uint64, len(current))
+
+	for k, processor := range processors {
+		bit := uint64(1) << uint(k%64)
+
+		output, offsets := applyProcessor(processor, view.processed, len(current))
+		outRunes := []rune(output)
+
+		// Runes produced from one input rune more or less than once were rewritten
+		counts := make([]int, len(current))
+		for _, offset := range offsets {
+			counts[offset]++
+		}
+
+		origin := make([]int, len(outRunes))
+		changed := make([]uint64, len(outRunes))
+		gaps := make([]uint64, len(outRunes))
+		for j, offset := range offsets {
+			origin[j] = view.origin[offset]
+			changed[j] = view.changed[offset]
+			if outRunes[j] != current[offset] || counts[offset] != 1 {
+				changed[j] |= bit
+			}
+
+			// Carry gaps only on the first rune produced from an input rune
+			if j == 0 || offsets[j-1] != offset {
+				gaps[j] = view.gaps[offset]
+				if j > 0 && offset-offsets[j-1] > 1 {
+					gaps[j] |= bit
+				}
+			}
+		}
+
+		view.processed = output
+		view.stages = append(view.stages, output)
+		view.origin, view.changed, view.gaps = origin, changed, gaps
+		current = outRunes
+	}
+
+	return view
+}
+
+// release returns the pooled buffers of the view; the view must not be used afterwards
+func (v *textView) release() {
+	pool.PutInts(v.pooled)
+	v.pooled, v.bytes = nil, nil
+}
+
+// applyProcessor runs a processor and returns its output with input rune offsets
+// Processors that do not implement variant.Mapper are assumed to map runes one to one
+// when the rune count is unchanged; otherwise offsets are clamped to the input length
+func applyProcessor(processor variant.Processor, text string, inputLen int) (string, []int) {
+	if mapper, ok := processor.(variant.Mapper); ok {
+		return mapper.ProcessWithOffsets(text)
+	}
+
+	output := processor.Process(text)
+	if inputLen == 0 {
+		return "", nil
+	}
+	offsets := make([]int, utf8.RuneCountInString(output))
+	for i := range offsets {
+		offsets[i] = i
+		if i >= inputLen {
+			offsets[i] = inputLen - 1
+		}
+	}
+	return output, offsets
+}
+
+// span maps a processed rune range onto the original text
+// It returns the original rune range and the processors that took part in the match
+func (v *textView) span(start, end int) (int, int, uint64) {
+	if v.origin == nil {
+		return start, end, 0
+	}
+	if len(v.origin) == 0 {
+		return 0, 0, 0
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if end > len(v.origin) {
+		end = len(v.origin)
+	}
+	if end <= start {
+		end = start + 1
+	}
+
+	var used uint64
+	for i := start; i < end; i++ {
+		used |= v.changed[i]
+		if i > start {
+			used |= v.gaps[i]
+		}
+	}
+
+	return v.origin[start], v.origin[end-1] + 1, used
+}
+
+// position returns the 1-based line and rune column of a rune index in the original text
+func (v *textView) position(index int) (int, int) {
+	if v.lines == nil {
+		v.lines = []int{0}
+		i := 0
+		for _, r := range v.original {
+			i++
+			if r == '\n' {
+				v.lines = append(v.lines, i)
+			}
+		}
+	}
+
+	line := sort.Search(len(v.lines), func(i int) bool { return v.lines[i] > index }) - 1
+	return line + 1, index - v.lines[line] + 1
+}
+
+// locate fills in the original text, offsets, position and variants of a match
+func (v *textView) locate(match *Match, processors []variant.Processor) {
+	start, end, used := v.span(match.Start, match.End)
+
+	match.Start, match.End = start, end
+	match.ByteStart, match.ByteEnd = v.bytes[start], v.bytes[end]
+	match.Text = v.original[match.ByteStart:match.ByteEnd]
+	if !v.masking {
+		match.Line, match.Column = v.position(start)
+	}
+
+	for k, processor := range processors {
+		if used&(uint64(1)<<uint(k%64)) != 0 {
+			match.Variants = append(match.Variants, processor.Name())
+		}
+	}
+}
+
+// mask replaces the original runes covered by located matches with repl
+func (v *textView) mask(matches []Match, repl rune) string {
+	if len(matches) == 0 {
+		return v.original
+	}
+
+	buffer := pool.GetRunes(len(v.bytes))
+	defer pool.PutRunes(buffer)
+
+	runes := *buffer
+	for _, r := range v.original {
+		runes = append(runes, r)
+	}
+	*buffer = runes
+
+	for _, m := range matches {
+		for i := m.Start; i < m.End && i < len(runes); i++ {
+			runes[i] = repl
+		}
+	}
+	return string(runes)
+}
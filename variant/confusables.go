@@ -0,0 +1,139 @@
+package variant
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ConfusableProcessor replaces look-alike characters with their prototype from
+// the Unicode confusables data (UTS #39), producing a skeleton of the text:
+// Cyrillic "а" and Greek "ο" become Latin "a" and "o", "1" and "I" become "l"
+// Dictionary words must be compared by their skeleton as well. The tables are
+// generated by gen_confusables.go
+type ConfusableProcessor struct {
+	crossScriptOnly bool
+	caseSensitive   bool
+}
+
+// NewConfusableProcessor creates a new confusables processor
+// With crossScriptOnly, only characters that imitate another script are replaced,
+// such as Cyrillic or Greek letters posing as Latin ones; look-alikes within a
+// script, such as "1" and "l" or "rn" and "m", are kept
+// Case is folded as FoldedSkeleton does, so that "I" stays "i" instead of becoming "l"
+func NewConfusableProcessor(crossScriptOnly bool) *ConfusableProcessor {
+	return &ConfusableProcessor{crossScriptOnly: crossScriptOnly}
+}
+
+// NewCaseSensitiveConfusableProcessor creates a confusables processor that
+// keeps the case of text, for case-sensitive matching
+func NewCaseSensitiveConfusableProcessor(crossScriptOnly bool) *ConfusableProcessor {
+	return &ConfusableProcessor{crossScriptOnly: crossScriptOnly, caseSensitive: true}
+}
+
+// Process returns the skeleton of text
+func (p *ConfusableProcessor) Process(text string) string {
+	if p.caseSensitive {
+		return Skeleton(text, p.crossScriptOnly)
+	}
+	return FoldedSkeleton(text, p.crossScriptOnly)
+}
+
+// ProcessWithOffsets returns the skeleton of text and maps every output rune to
+// the input rune it came from
+func (p *ConfusableProcessor) ProcessWithOffsets(text string) (string, []int) {
+	var builder strings.Builder
+	builder.Grow(len(text))
+	offsets := make([]int, 0, len(text))
+
+	i := 0
+	for _, r := range text {
+		prototype, exists := confusable(r, p.crossScriptOnly)
+		if !p.caseSensitive {
+			prototype, exists = foldedConfusable(r, p.crossScriptOnly), true
+		}
+		if exists {
+			builder.WriteString(prototype)
+			for range prototype {
+				offsets = append(offsets, i)
+			}
+		} else {
+			builder.WriteRune(r)
+			offsets = append(offsets, i)
+		}
+		i++
+	}
+
+	return builder.String(), offsets
+}
+
+// Name returns the processor name
+func (p *ConfusableProcessor) Name() string {
+	return "confusables"
+}
+
+// CrossScriptOnly reports whether only cross-script confusables are replaced
+func (p *ConfusableProcessor) CrossScriptOnly() bool {
+	return p.crossScriptOnly
+}
+
+// Skeleton replaces every confusable character of text with its prototype
+// Two strings that look alike have the same skeleton
+func Skeleton(text string, crossScriptOnly bool) string {
+	var builder strings.Builder
+	builder.Grow(len(text))
+
+	for _, r := range text {
+		if prototype, exists := confusable(r, crossScriptOnly); exists {
+			builder.WriteString(prototype)
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// FoldedSkeleton returns the lower-cased skeleton of text, for case-insensitive comparison
+// Latin letters are lower-cased before taking the skeleton: the prototype of
+// "I" is "l", so taking it first would make "KILL" differ from "kill". Capitals
+// of other scripts imitate Latin capitals (Cyrillic "В" looks like "B", while
+// "в" does not look like "b"), so they are lower-cased after, except the
+// I-like capitals whose prototype is "l"
+func FoldedSkeleton(text string, crossScriptOnly bool) string {
+	var builder strings.Builder
+	builder.Grow(len(text))
+
+	for _, r := range text {
+		builder.WriteString(foldedConfusable(r, crossScriptOnly))
+	}
+
+	return builder.String()
+}
+
+// foldedConfusable returns the lower-cased prototype of r, or r lower-cased
+func foldedConfusable(r rune, crossScriptOnly bool) string {
+	lower := unicode.ToLower(r)
+	if lower != r && !unicode.Is(unicode.Latin, r) {
+		if prototype, exists := confusable(r, crossScriptOnly); exists && prototype != "l" {
+			return strings.ToLower(prototype)
+		}
+	}
+
+	if prototype, exists := confusable(lower, crossScriptOnly); exists {
+		return strings.ToLower(prototype)
+	}
+	return string(lower)
+}
+
+// confusable returns the prototype of r, if r has one
+func confusable(r rune, crossScriptOnly bool) (string, bool) {
+	i := sort.Search(len(confusableKeys), func(i int) bool { return confusableKeys[i] >= r })
+	if i == len(confusableKeys) || confusableKeys[i] != r {
+		return "", false
+	}
+	if crossScriptOnly && confusableCross[i] != '1' {
+		return "", false
+	}
+	return confusableValues[i], true
+}
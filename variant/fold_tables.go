@@ -0,0 +1,1719 @@
+// Code generated by gen_fold.go; DO NOT EDIT.
+
+package variant
+
+// foldKeys lists the code points that fold to something else, in ascending order
+var foldKeys = [...]rune{
+	0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048,
+	0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050,
+	0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058,
+	0x0059, 0x005A, 0x00A0, 0x00A8, 0x00AA, 0x00AF, 0x00B2, 0x00B3,
+	0x00B4, 0x00B5, 0x00B8, 0x00B9, 0x00BA, 0x00BC, 0x00BD, 0x00BE,
+	0x00C0, 0x00C1, 0x00C2, 0x00C3, 0x00C4, 0x00C5, 0x00C6, 0x00C7,
+	0x00C8, 0x00C9, 0x00CA, 0x00CB, 0x00CC, 0x00CD, 0x00CE, 0x00CF,
+	0x00D0, 0x00D1, 0x00D2, 0x00D3, 0x00D4, 0x00D5, 0x00D6, 0x00D8,
+	0x00D9, 0x00DA, 0x00DB, 0x00DC, 0x00DD, 0x00DE, 0x00DF, 0x00E0,
+	0x00E1, 0x00E2, 0x00E3, 0x00E4, 0x00E5, 0x00E7, 0x00E8, 0x00E9,
+	0x00EA, 0x00EB, 0x00EC, 0x00ED, 0x00EE, 0x00EF, 0x00F1, 0x00F2,
+	0x00F3, 0x00F4, 0x00F5, 0x00F6, 0x00F9, 0x00FA, 0x00FB, 0x00FC,
+	0x00FD, 0x00FF, 0x0100, 0x0101, 0x0102, 0x0103, 0x0104, 0x0105,
+	0x0106, 0x0107, 0x0108, 0x0109, 0x010A, 0x010B, 0x010C, 0x010D,
+	0x010E, 0x010F, 0x0110, 0x0112, 0x0113, 0x0114, 0x0115, 0x0116,
+	0x0117, 0x0118, 0x0119, 0x011A, 0x011B, 0x011C, 0x011D, 0x011E,
+	0x011F, 0x0120, 0x0121, 0x0122, 0x0123, 0x0124, 0x0125, 0x0126,
+	0x0128, 0x0129, 0x012A, 0x012B, 0x012C, 0x012D, 0x012E, 0x012F,
+	0x0130, 0x0132, 0x0133, 0x0134, 0x0135, 0x0136, 0x0137, 0x0139,
+	0x013A, 0x013B, 0x013C, 0x013D, 0x013E, 0x013F, 0x0140, 0x0141,
+	0x0143, 0x0144, 0x0145, 0x0146, 0x0147, 0x0148, 0x0149, 0x014A,
+	0x014C, 0x014D, 0x014E, 0x014F, 0x0150, 0x0151, 0x0152, 0x0154,
+	0x0155, 0x0156, 0x0157, 0x0158, 0x0159, 0x015A, 0x015B, 0x015C,
+	0x015D, 0x015E, 0x015F, 0x0160, 0x0161, 0x0162, 0x0163, 0x0164,
+	0x0165, 0x0166, 0x0168, 0x0169, 0x016A, 0x016B, 0x016C, 0x016D,
+	0x016E, 0x016F, 0x0170, 0x0171, 0x0172, 0x0173, 0x0174, 0x0175,
+	0x0176, 0x0177, 0x0178, 0x0179, 0x017A, 0x017B, 0x017C, 0x017D,
+	0x017E, 0x017F, 0x0181, 0x0182, 0x0184, 0x0186, 0x0187, 0x0189,
+	0x018A, 0x018B, 0x018E, 0x018F, 0x0190, 0x0191, 0x0193, 0x0194,
+	0x0196, 0x0197, 0x0198, 0x019C, 0x019D, 0x019F, 0x01A0, 0x01A1,
+	0x01A2, 0x01A4, 0x01A6, 0x01A7, 0x01A9, 0x01AC, 0x01AE, 0x01AF,
+	0x01B0, 0x01B1, 0x01B2, 0x01B3, 0x01B5, 0x01B7, 0x01B8, 0x01BC,
+	0x01C4, 0x01C5, 0x01C6, 0x01C7, 0x01C8, 0x01C9, 0x01CA, 0x01CB,
+	0x01CC, 0x01CD, 0x01CE, 0x01CF, 0x01D0, 0x01D1, 0x01D2, 0x01D3,
+	0x01D4, 0x01D5, 0x01D6, 0x01D7, 0x01D8, 0x01D9, 0x01DA, 0x01DB,
+	0x01DC, 0x01DE, 0x01DF, 0x01E0, 0x01E1, 0x01E2, 0x01E3, 0x01E4,
+	0x01E6, 0x01E7, 0x01E8, 0x01E9, 0x01EA, 0x01EB, 0x01EC, 0x01ED,
+	0x01EE, 0x01EF, 0x01F0, 0x01F1, 0x01F2, 0x01F3, 0x01F4, 0x01F5,
+	0x01F6, 0x01F7, 0x01F8, 0x01F9, 0x01FA, 0x01FB, 0x01FC, 0x01FD,
+	0x01FE, 0x01FF, 0x0200, 0x0201, 0x0202, 0x0203, 0x0204, 0x0205,
+	0x0206, 0x0207, 0x0208, 0x0209, 0x020A, 0x020B, 0x020C, 0x020D,
+	0x020E, 0x020F, 0x0210, 0x0211, 0x0212, 0x0213, 0x0214, 0x0215,
+	0x0216, 0x0217, 0x0218, 0x0219, 0x021A, 0x021B, 0x021C, 0x021E,
+	0x021F, 0x0220, 0x0222, 0x0224, 0x0226, 0x0227, 0x0228, 0x0229,
+	0x022A, 0x022B, 0x022C, 0x022D, 0x022E, 0x022F, 0x0230, 0x0231,
+	0x0232, 0x0233, 0x023A, 0x023B, 0x023D, 0x023E, 0x0241, 0x0243,
+	0x0244, 0x0245, 0x0246, 0x0248, 0x024A, 0x024C, 0x024E, 0x02B0,
+	0x02B1, 0x02B2, 0x02B3, 0x02B4, 0x02B5, 0x02B6, 0x02B7, 0x02B8,
+	0x02D8, 0x02D9, 0x02DA, 0x02DB, 0x02DC, 0x02DD, 0x02E0, 0x02E1,
+	0x02E2, 0x02E3, 0x02E4, 0x0300, 0x0301, 0x0302, 0x0303, 0x0304,
+	0x0305, 0x0306, 0x0307, 0x0308, 0x0309, 0x030A, 0x030B, 0x030C,
+	0x030D, 0x030E, 0x030F, 0x0310, 0x0311, 0x0312, 0x0313, 0x0314,
+	0x0315, 0x0316, 0x0317, 0x0318, 0x0319, 0x031A, 0x031B, 0x031C,
+	0x031D, 0x031E, 0x031F, 0x0320, 0x0321, 0x0322, 0x0323, 0x0324,
+	0x0325, 0x0326, 0x0327, 0x0328, 0x0329, 0x032A, 0x032B, 0x032C,
+	0x032D, 0x032E, 0x032F, 0x0330, 0x0331, 0x0332, 0x0333, 0x0334,
+	0x0335, 0x0336, 0x0337, 0x0338, 0x0339, 0x033A, 0x033B, 0x033C,
+	0x033D, 0x033E, 0x033F, 0x0340, 0x0341, 0x0342, 0x0343, 0x0344,
+	0x0345, 0x0346, 0x0347, 0x0348, 0x0349, 0x034A, 0x034B, 0x034C,
+	0x034D, 0x034E, 0x034F, 0x0350, 0x0351, 0x0352, 0x0353, 0x0354,
+	0x0355, 0x0356, 0x0357, 0x0358, 0x0359, 0x035A, 0x035B, 0x035C,
+	0x035D, 0x035E, 0x035F, 0x0360, 0x0361, 0x0362, 0x0363, 0x0364,
+	0x0365, 0x0366, 0x0367, 0x0368, 0x0369, 0x036A, 0x036B, 0x036C,
+	0x036D, 0x036E, 0x036F, 0x0370, 0x0372, 0x0374, 0x0376, 0x037A,
+	0x037E, 0x037F, 0x0384, 0x0385, 0x0386, 0x0387, 0x0388, 0x0389,
+	0x038A, 0x038C, 0x038E, 0x038F, 0x0390, 0x0391, 0x0392, 0x0393,
+	0x0394, 0x0395, 0x0396, 0x0397, 0x0398, 0x0399, 0x039A, 0x039B,
+	0x039C, 0x039D, 0x039E, 0x039F, 0x03A0, 0x03A1, 0x03A3, 0x03A4,
+	0x03A5, 0x03A6, 0x03A7, 0x03A8, 0x03A9, 0x03AA, 0x03AB, 0x03AC,
+	0x03AD, 0x03AE, 0x03AF, 0x03B0, 0x03C2, 0x03CA, 0x03CB, 0x03CC,
+	0x03CD, 0x03CE, 0x03CF, 0x03D0, 0x03D1, 0x03D2, 0x03D3, 0x03D4,
+	0x03D5, 0x03D6, 0x03D8, 0x03DA, 0x03DC, 0x03DE, 0x03E0, 0x03E2,
+	0x03E4, 0x03E6, 0x03E8, 0x03EA, 0x03EC, 0x03EE, 0x03F0, 0x03F1,
+	0x03F2, 0x03F4, 0x03F5, 0x03F7, 0x03F9, 0x03FA, 0x03FD, 0x03FE,
+	0x03FF, 0x0400, 0x0401, 0x0402, 0x0403, 0x0404, 0x0405, 0x0406,
+	0x0407, 0x0408, 0x0409, 0x040A, 0x040B, 0x040C, 0x040D, 0x040E,
+	0x040F, 0x0410, 0x0411, 0x0412, 0x0413, 0x0414, 0x0415, 0x0416,
+	0x0417, 0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E,
+	0x041F, 0x0420, 0x0421, 0x0422, 0x0423, 0x0424, 0x0425, 0x0426,
+	0x0427, 0x0428, 0x0429, 0x042A, 0x042B, 0x042C, 0x042D, 0x042E,
+	0x042F, 0x0439, 0x0450, 0x0451, 0x0453, 0x0457, 0x045C, 0x045D,
+	0x045E, 0x0460, 0x0462, 0x0464, 0x0466, 0x0468, 0x046A, 0x046C,
+	0x046E, 0x0470, 0x0472, 0x0474, 0x0476, 0x0477, 0x0478, 0x047A,
+	0x047C, 0x047E, 0x0480, 0x048A, 0x048C, 0x048E, 0x0490, 0x0492,
+	0x0494, 0x0496, 0x0498, 0x049A, 0x049C, 0x049E, 0x04A0, 0x04A2,
+	0x04A4, 0x04A6, 0x04A8, 0x04AA, 0x04AC, 0x04AE, 0x04B0, 0x04B2,
+	0x04B4, 0x04B6, 0x04B8, 0x04BA, 0x04BC, 0x04BE, 0x04C0, 0x04C1,
+	0x04C2, 0x04C3, 0x04C5, 0x04C7, 0x04C9, 0x04CB, 0x04CD, 0x04D0,
+	0x04D1, 0x04D2, 0x04D3, 0x04D4, 0x04D6, 0x04D7, 0x04D8, 0x04DA,
+	0x04DB, 0x04DC, 0x04DD, 0x04DE, 0x04DF, 0x04E0, 0x04E2, 0x04E3,
+	0x04E4, 0x04E5, 0x04E6, 0x04E7, 0x04E8, 0x04EA, 0x04EB, 0x04EC,
+	0x04ED, 0x04EE, 0x04EF, 0x04F0, 0x04F1, 0x04F2, 0x04F3, 0x04F4,
+	0x04F5, 0x04F6, 0x04F8, 0x04F9, 0x04FA, 0x04FC, 0x04FE, 0x0500,
+	0x0502, 0x0504, 0x0506, 0x0508, 0x050A, 0x050C, 0x050E, 0x0510,
+	0x0512, 0x0514, 0x0516, 0x0518, 0x051A, 0x051C, 0x051E, 0x0520,
+	0x0522, 0x0524, 0x0526, 0x0528, 0x052A, 0x052C, 0x052E, 0x0531,
+	0x0532, 0x0533, 0x0534, 0x0535, 0x0536, 0x0537, 0x0538, 0x0539,
+	0x053A, 0x053B, 0x053C, 0x053D, 0x053E, 0x053F, 0x0540, 0x0541,
+	0x0542, 0x0543, 0x0544, 0x0545, 0x0546, 0x0547, 0x0548, 0x0549,
+	0x054A, 0x054B, 0x054C, 0x054D, 0x054E, 0x054F, 0x0550, 0x0551,
+	0x0552, 0x0553, 0x0554, 0x0555, 0x0556, 0x0587, 0x0675, 0x0676,
+	0x0677, 0x0678, 0x0E33, 0x0EB3, 0x0EDC, 0x0EDD, 0x0F0C, 0x0F77,
+	0x0F79, 0x10A0, 0x10A1, 0x10A2, 0x10A3, 0x10A4, 0x10A5, 0x10A6,
+	0x10A7, 0x10A8, 0x10A9, 0x10AA, 0x10AB, 0x10AC, 0x10AD, 0x10AE,
+	0x10AF, 0x10B0, 0x10B1, 0x10B2, 0x10B3, 0x10B4, 0x10B5, 0x10B6,
+	0x10B7, 0x10B8, 0x10B9, 0x10BA, 0x10BB, 0x10BC, 0x10BD, 0x10BE,
+	0x10BF, 0x10C0, 0x10C1, 0x10C2, 0x10C3, 0x10C4, 0x10C5, 0x10C7,
+	0x10CD, 0x10FC, 0x13F8, 0x13F9, 0x13FA, 0x13FB, 0x13FC, 0x13FD,
+	0x1AB0, 0x1AB1, 0x1AB2, 0x1AB3, 0x1AB4, 0x1AB5, 0x1AB6, 0x1AB7,
+	0x1AB8, 0x1AB9, 0x1ABA, 0x1ABB, 0x1ABC, 0x1ABD, 0x1ABE, 0x1ABF,
+	0x1AC0, 0x1AC1, 0x1AC2, 0x1AC3, 0x1AC4, 0x1AC5, 0x1AC6, 0x1AC7,
+	0x1AC8, 0x1AC9, 0x1ACA, 0x1ACB, 0x1ACC, 0x1ACD, 0x1ACE, 0x1C80,
+	0x1C81, 0x1C82, 0x1C83, 0x1C84, 0x1C85, 0x1C86, 0x1C87, 0x1C88,
+	0x1C90, 0x1C91, 0x1C92, 0x1C93, 0x1C94, 0x1C95, 0x1C96, 0x1C97,
+	0x1C98, 0x1C99, 0x1C9A, 0x1C9B, 0x1C9C, 0x1C9D, 0x1C9E, 0x1C9F,
+	0x1CA0, 0x1CA1, 0x1CA2, 0x1CA3, 0x1CA4, 0x1CA5, 0x1CA6, 0x1CA7,
+	0x1CA8, 0x1CA9, 0x1CAA, 0x1CAB, 0x1CAC, 0x1CAD, 0x1CAE, 0x1CAF,
+	0x1CB0, 0x1CB1, 0x1CB2, 0x1CB3, 0x1CB4, 0x1CB5, 0x1CB6, 0x1CB7,
+	0x1CB8, 0x1CB9, 0x1CBA, 0x1CBD, 0x1CBE, 0x1CBF, 0x1D2C, 0x1D2D,
+	0x1D2E, 0x1D30, 0x1D31, 0x1D32, 0x1D33, 0x1D34, 0x1D35, 0x1D36,
+	0x1D37, 0x1D38, 0x1D39, 0x1D3A, 0x1D3C, 0x1D3D, 0x1D3E, 0x1D3F,
+	0x1D40, 0x1D41, 0x1D42, 0x1D43, 0x1D44, 0x1D45, 0x1D46, 0x1D47,
+	0x1D48, 0x1D49, 0x1D4A, 0x1D4B, 0x1D4C, 0x1D4D, 0x1D4F, 0x1D50,
+	0x1D51, 0x1D52, 0x1D53, 0x1D54, 0x1D55, 0x1D56, 0x1D57, 0x1D58,
+	0x1D59, 0x1D5A, 0x1D5B, 0x1D5C, 0x1D5D, 0x1D5E, 0x1D5F, 0x1D60,
+	0x1D61, 0x1D62, 0x1D63, 0x1D64, 0x1D65, 0x1D66, 0x1D67, 0x1D68,
+	0x1D69, 0x1D6A, 0x1D78, 0x1D9B, 0x1D9C, 0x1D9D, 0x1D9E, 0x1D9F,
+	0x1DA0, 0x1DA1, 0x1DA2, 0x1DA3, 0x1DA4, 0x1DA5, 0x1DA6, 0x1DA7,
+	0x1DA8, 0x1DA9, 0x1DAA, 0x1DAB, 0x1DAC, 0x1DAD, 0x1DAE, 0x1DAF,
+	0x1DB0, 0x1DB1, 0x1DB2, 0x1DB3, 0x1DB4, 0x1DB5, 0x1DB6, 0x1DB7,
+	0x1DB8, 0x1DB9, 0x1DBA, 0x1DBB, 0x1DBC, 0x1DBD, 0x1DBE, 0x1DBF,
+	0x1DC0, 0x1DC1, 0x1DC2, 0x1DC3, 0x1DC4, 0x1DC5, 0x1DC6, 0x1DC7,
+	0x1DC8, 0x1DC9, 0x1DCA, 0x1DCB, 0x1DCC, 0x1DCD, 0x1DCE, 0x1DCF,
+	0x1DD0, 0x1DD1, 0x1DD2, 0x1DD3, 0x1DD4, 0x1DD5, 0x1DD6, 0x1DD7,
+	0x1DD8, 0x1DD9, 0x1DDA, 0x1DDB, 0x1DDC, 0x1DDD, 0x1DDE, 0x1DDF,
+	0x1DE0, 0x1DE1, 0x1DE2, 0x1DE3, 0x1DE4, 0x1DE5, 0x1DE6, 0x1DE7,
+	0x1DE8, 0x1DE9, 0x1DEA, 0x1DEB, 0x1DEC, 0x1DED, 0x1DEE, 0x1DEF,
+	0x1DF0, 0x1DF1, 0x1DF2, 0x1DF3, 0x1DF4, 0x1DF5, 0x1DF6, 0x1DF7,
+	0x1DF8, 0x1DF9, 0x1DFA, 0x1DFB, 0x1DFC, 0x1DFD, 0x1DFE, 0x1DFF,
+	0x1E00, 0x1E01, 0x1E02, 0x1E03, 0x1E04, 0x1E05, 0x1E06, 0x1E07,
+	0x1E08, 0x1E09, 0x1E0A, 0x1E0B, 0x1E0C, 0x1E0D, 0x1E0E, 0x1E0F,
+	0x1E10, 0x1E11, 0x1E12, 0x1E13, 0x1E14, 0x1E15, 0x1E16, 0x1E17,
+	0x1E18, 0x1E19, 0x1E1A, 0x1E1B, 0x1E1C, 0x1E1D, 0x1E1E, 0x1E1F,
+	0x1E20, 0x1E21, 0x1E22, 0x1E23, 0x1E24, 0x1E25, 0x1E26, 0x1E27,
+	0x1E28, 0x1E29, 0x1E2A, 0x1E2B, 0x1E2C, 0x1E2D, 0x1E2E, 0x1E2F,
+	0x1E30, 0x1E31, 0x1E32, 0x1E33, 0x1E34, 0x1E35, 0x1E36, 0x1E37,
+	0x1E38, 0x1E39, 0x1E3A, 0x1E3B, 0x1E3C, 0x1E3D, 0x1E3E, 0x1E3F,
+	0x1E40, 0x1E41, 0x1E42, 0x1E43, 0x1E44, 0x1E45, 0x1E46, 0x1E47,
+	0x1E48, 0x1E49, 0x1E4A, 0x1E4B, 0x1E4C, 0x1E4D, 0x1E4E, 0x1E4F,
+	0x1E50, 0x1E51, 0x1E52, 0x1E53, 0x1E54, 0x1E55, 0x1E56, 0x1E57,
+	0x1E58, 0x1E59, 0x1E5A, 0x1E5B, 0x1E5C, 0x1E5D, 0x1E5E, 0x1E5F,
+	0x1E60, 0x1E61, 0x1E62, 0x1E63, 0x1E64, 0x1E65, 0x1E66, 0x1E67,
+	0x1E68, 0x1E69, 0x1E6A, 0x1E6B, 0x1E6C, 0x1E6D, 0x1E6E, 0x1E6F,
+	0x1E70, 0x1E71, 0x1E72, 0x1E73, 0x1E74, 0x1E75, 0x1E76, 0x1E77,
+	0x1E78, 0x1E79, 0x1E7A, 0x1E7B, 0x1E7C, 0x1E7D, 0x1E7E, 0x1E7F,
+	0x1E80, 0x1E81, 0x1E82, 0x1E83, 0x1E84, 0x1E85, 0x1E86, 0x1E87,
+	0x1E88, 0x1E89, 0x1E8A, 0x1E8B, 0x1E8C, 0x1E8D, 0x1E8E, 0x1E8F,
+	0x1E90, 0x1E91, 0x1E92, 0x1E93, 0x1E94, 0x1E95, 0x1E96, 0x1E97,
+	0x1E98, 0x1E99, 0x1E9A, 0x1E9B, 0x1E9E, 0x1EA0, 0x1EA1, 0x1EA2,
+	0x1EA3, 0x1EA4, 0x1EA5, 0x1EA6, 0x1EA7, 0x1EA8, 0x1EA9, 0x1EAA,
+	0x1EAB, 0x1EAC, 0x1EAD, 0x1EAE, 0x1EAF, 0x1EB0, 0x1EB1, 0x1EB2,
+	0x1EB3, 0x1EB4, 0x1EB5, 0x1EB6, 0x1EB7, 0x1EB8, 0x1EB9, 0x1EBA,
+	0x1EBB, 0x1EBC, 0x1EBD, 0x1EBE, 0x1EBF, 0x1EC0, 0x1EC1, 0x1EC2,
+	0x1EC3, 0x1EC4, 0x1EC5, 0x1EC6, 0x1EC7, 0x1EC8, 0x1EC9, 0x1ECA,
+	0x1ECB, 0x1ECC, 0x1ECD, 0x1ECE, 0x1ECF, 0x1ED0, 0x1ED1, 0x1ED2,
+	0x1ED3, 0x1ED4, 0x1ED5, 0x1ED6, 0x1ED7, 0x1ED8, 0x1ED9, 0x1EDA,
+	0x1EDB, 0x1EDC, 0x1EDD, 0x1EDE, 0x1EDF, 0x1EE0, 0x1EE1, 0x1EE2,
+	0x1EE3, 0x1EE4, 0x1EE5, 0x1EE6, 0x1EE7, 0x1EE8, 0x1EE9, 0x1EEA,
+	0x1EEB, 0x1EEC, 0x1EED, 0x1EEE, 0x1EEF, 0x1EF0, 0x1EF1, 0x1EF2,
+	0x1EF3, 0x1EF4, 0x1EF5, 0x1EF6, 0x1EF7, 0x1EF8, 0x1EF9, 0x1EFA,
+	0x1EFC, 0x1EFE, 0x1F00, 0x1F01, 0x1F02, 0x1F03, 0x1F04, 0x1F05,
+	0x1F06, 0x1F07, 0x1F08, 0x1F09, 0x1F0A, 0x1F0B, 0x1F0C, 0x1F0D,
+	0x1F0E, 0x1F0F, 0x1F10, 0x1F11, 0x1F12, 0x1F13, 0x1F14, 0x1F15,
+	0x1F18, 0x1F19, 0x1F1A, 0x1F1B, 0x1F1C, 0x1F1D, 0x1F20, 0x1F21,
+	0x1F22, 0x1F23, 0x1F24, 0x1F25, 0x1F26, 0x1F27, 0x1F28, 0x1F29,
+	0x1F2A, 0x1F2B, 0x1F2C, 0x1F2D, 0x1F2E, 0x1F2F, 0x1F30, 0x1F31,
+	0x1F32, 0x1F33, 0x1F34, 0x1F35, 0x1F36, 0x1F37, 0x1F38, 0x1F39,
+	0x1F3A, 0x1F3B, 0x1F3C, 0x1F3D, 0x1F3E, 0x1F3F, 0x1F40, 0x1F41,
+	0x1F42, 0x1F43, 0x1F44, 0x1F45, 0x1F48, 0x1F49, 0x1F4A, 0x1F4B,
+	0x1F4C, 0x1F4D, 0x1F50, 0x1F51, 0x1F52, 0x1F53, 0x1F54, 0x1F55,
+	0x1F56, 0x1F57, 0x1F59, 0x1F5B, 0x1F5D, 0x1F5F, 0x1F60, 0x1F61,
+	0x1F62, 0x1F63, 0x1F64, 0x1F65, 0x1F66, 0x1F67, 0x1F68, 0x1F69,
+	0x1F6A, 0x1F6B, 0x1F6C, 0x1F6D, 0x1F6E, 0x1F6F, 0x1F70, 0x1F71,
+	0x1F72, 0x1F73, 0x1F74, 0x1F75, 0x1F76, 0x1F77, 0x1F78, 0x1F79,
+	0x1F7A, 0x1F7B, 0x1F7C, 0x1F7D, 0x1F80, 0x1F81, 0x1F82, 0x1F83,
+	0x1F84, 0x1F85, 0x1F86, 0x1F87, 0x1F88, 0x1F89, 0x1F8A, 0x1F8B,
+	0x1F8C, 0x1F8D, 0x1F8E, 0x1F8F, 0x1F90, 0x1F91, 0x1F92, 0x1F93,
+	0x1F94, 0x1F95, 0x1F96, 0x1F97, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B,
+	0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1FA2, 0x1FA3,
+	0x1FA4, 0x1FA5, 0x1FA6, 0x1FA7, 0x1FA8, 0x1FA9, 0x1FAA, 0x1FAB,
+	0x1FAC, 0x1FAD, 0x1FAE, 0x1FAF, 0x1FB0, 0x1FB1, 0x1FB2, 0x1FB3,
+	0x1FB4, 0x1FB6, 0x1FB7, 0x1FB8, 0x1FB9, 0x1FBA, 0x1FBB, 0x1FBC,
+	0x1FBD, 0x1FBE, 0x1FBF, 0x1FC0, 0x1FC1, 0x1FC2, 0x1FC3, 0x1FC4,
+	0x1FC6, 0x1FC7, 0x1FC8, 0x1FC9, 0x1FCA, 0x1FCB, 0x1FCC, 0x1FCD,
+	0x1FCE, 0x1FCF, 0x1FD0, 0x1FD1, 0x1FD2, 0x1FD3, 0x1FD6, 0x1FD7,
+	0x1FD8, 0x1FD9, 0x1FDA, 0x1FDB, 0x1FDD, 0x1FDE, 0x1FDF, 0x1FE0,
+	0x1FE1, 0x1FE2, 0x1FE3, 0x1FE4, 0x1FE5, 0x1FE6, 0x1FE7, 0x1FE8,
+	0x1FE9, 0x1FEA, 0x1FEB, 0x1FEC, 0x1FED, 0x1FEE, 0x1FEF, 0x1FF2,
+	0x1FF3, 0x1FF4, 0x1FF6, 0x1FF7, 0x1FF8, 0x1FF9, 0x1FFA, 0x1FFB,
+	0x1FFC, 0x1FFD, 0x1FFE, 0x2000, 0x2001, 0x2002, 0x2003, 0x2004,
+	0x2005, 0x2006, 0x2007, 0x2008, 0x2009, 0x200A, 0x2011, 0x2017,
+	0x2024, 0x2025, 0x2026, 0x202F, 0x2033, 0x2034, 0x2036, 0x2037,
+	0x203C, 0x203E, 0x2047, 0x2048, 0x2049, 0x2057, 0x205F, 0x2070,
+	0x2071, 0x2074, 0x2075, 0x2076, 0x2077, 0x2078, 0x2079, 0x207A,
+	0x207B, 0x207C, 0x207D, 0x207E, 0x207F, 0x2080, 0x2081, 0x2082,
+	0x2083, 0x2084, 0x2085, 0x2086, 0x2087, 0x2088, 0x2089, 0x208A,
+	0x208B, 0x208C, 0x208D, 0x208E, 0x2090, 0x2091, 0x2092, 0x2093,
+	0x2094, 0x2095, 0x2096, 0x2097, 0x2098, 0x2099, 0x209A, 0x209B,
+	0x209C, 0x20A8, 0x20D0, 0x20D1, 0x20D2, 0x20D3, 0x20D4, 0x20D5,
+	0x20D6, 0x20D7, 0x20D8, 0x20D9, 0x20DA, 0x20DB, 0x20DC, 0x20DD,
+	0x20DE, 0x20DF, 0x20E0, 0x20E1, 0x20E2, 0x20E3, 0x20E4, 0x20E5,
+	0x20E6, 0x20E7, 0x20E8, 0x20E9, 0x20EA, 0x20EB, 0x20EC, 0x20ED,
+	0x20EE, 0x20EF, 0x20F0, 0x2100, 0x2101, 0x2102, 0x2103, 0x2105,
+	0x2106, 0x2107, 0x2109, 0x210A, 0x210B, 0x210C, 0x210D, 0x210E,
+	0x210F, 0x2110, 0x2111, 0x2112, 0x2113, 0x2115, 0x2116, 0x2119,
+	0x211A, 0x211B, 0x211C, 0x211D, 0x2120, 0x2121, 0x2122, 0x2124,
+	0x2126, 0x2128, 0x212A, 0x212B, 0x212C, 0x212D, 0x212F, 0x2130,
+	0x2131, 0x2132, 0x2133, 0x2134, 0x2135, 0x2136, 0x2137, 0x2138,
+	0x2139, 0x213B, 0x213C, 0x213D, 0x213E, 0x213F, 0x2140, 0x2145,
+	0x2146, 0x2147, 0x2148, 0x2149, 0x2150, 0x2151, 0x2152, 0x2153,
+	0x2154, 0x2155, 0x2156, 0x2157, 0x2158, 0x2159, 0x215A, 0x215B,
+	0x215C, 0x215D, 0x215E, 0x215F, 0x2160, 0x2161, 0x2162, 0x2163,
+	0x2164, 0x2165, 0x2166, 0x2167, 0x2168, 0x2169, 0x216A, 0x216B,
+	0x216C, 0x216D, 0x216E, 0x216F, 0x2170, 0x2171, 0x2172, 0x2173,
+	0x2174, 0x2175, 0x2176, 0x2177, 0x2178, 0x2179, 0x217A, 0x217B,
+	0x217C, 0x217D, 0x217E, 0x217F, 0x2183, 0x2189, 0x219A, 0x219B,
+	0x21AE, 0x21CD, 0x21CE, 0x21CF, 0x2204, 0x2209, 0x220C, 0x2224,
+	0x2226, 0x222C, 0x222D, 0x222F, 0x2230, 0x2241, 0x2244, 0x2247,
+	0x2249, 0x2260, 0x2262, 0x226D, 0x226E, 0x226F, 0x2270, 0x2271,
+	0x2274, 0x2275, 0x2278, 0x2279, 0x2280, 0x2281, 0x2284, 0x2285,
+	0x2288, 0x2289, 0x22AC, 0x22AD, 0x22AE, 0x22AF, 0x22E0, 0x22E1,
+	0x22E2, 0x22E3, 0x22EA, 0x22EB, 0x22EC, 0x22ED, 0x2329, 0x232A,
+	0x2460, 0x2461, 0x2462, 0x2463, 0x2464, 0x2465, 0x2466, 0x2467,
+	0x2468, 0x2469, 0x246A, 0x246B, 0x246C, 0x246D, 0x246E, 0x246F,
+	0x2470, 0x2471, 0x2472, 0x2473, 0x2474, 0x2475, 0x2476, 0x2477,
+	0x2478, 0x2479, 0x247A, 0x247B, 0x247C, 0x247D, 0x247E, 0x247F,
+	0x2480, 0x2481, 0x2482, 0x2483, 0x2484, 0x2485, 0x2486, 0x2487,
+	0x2488, 0x2489, 0x248A, 0x248B, 0x248C, 0x248D, 0x248E, 0x248F,
+	0x2490, 0x2491, 0x2492, 0x2493, 0x2494, 0x2495, 0x2496, 0x2497,
+	0x2498, 0x2499, 0x249A, 0x249B, 0x249C, 0x249D, 0x249E, 0x249F,
+	0x24A0, 0x24A1, 0x24A2, 0x24A3, 0x24A4, 0x24A5, 0x24A6, 0x24A7,
+	0x24A8, 0x24A9, 0x24AA, 0x24AB, 0x24AC, 0x24AD, 0x24AE, 0x24AF,
+	0x24B0, 0x24B1, 0x24B2, 0x24B3, 0x24B4, 0x24B5, 0x24B6, 0x24B7,
+	0x24B8, 0x24B9, 0x24BA, 0x24BB, 0x24BC, 0x24BD, 0x24BE, 0x24BF,
+	0x24C0, 0x24C1, 0x24C2, 0x24C3, 0x24C4, 0x24C5, 0x24C6, 0x24C7,
+	0x24C8, 0x24C9, 0x24CA, 0x24CB, 0x24CC, 0x24CD, 0x24CE, 0x24CF,
+	0x24D0, 0x24D1, 0x24D2, 0x24D3, 0x24D4, 0x24D5, 0x24D6, 0x24D7,
+	0x24D8, 0x24D9, 0x24DA, 0x24DB, 0x24DC, 0x24DD, 0x24DE, 0x24DF,
+	0x24E0, 0x24E1, 0x24E2, 0x24E3, 0x24E4, 0x24E5, 0x24E6, 0x24E7,
+	0x24E8, 0x24E9, 0x24EA, 0x2A0C, 0x2A74, 0x2A75, 0x2A76, 0x2ADC,
+	0x2C00, 0x2C01, 0x2C02, 0x2C03, 0x2C04, 0x2C05, 0x2C06, 0x2C07,
+	0x2C08, 0x2C09, 0x2C0A, 0x2C0B, 0x2C0C, 0x2C0D, 0x2C0E, 0x2C0F,
+	0x2C10, 0x2C11, 0x2C12, 0x2C13, 0x2C14, 0x2C15, 0x2C16, 0x2C17,
+	0x2C18, 0x2C19, 0x2C1A, 0x2C1B, 0x2C1C, 0x2C1D, 0x2C1E, 0x2C1F,
+	0x2C20, 0x2C21, 0x2C22, 0x2C23, 0x2C24, 0x2C25, 0x2C26, 0x2C27,
+	0x2C28, 0x2C29, 0x2C2A, 0x2C2B, 0x2C2C, 0x2C2D, 0x2C2E, 0x2C2F,
+	0x2C60, 0x2C62, 0x2C63, 0x2C64, 0x2C67, 0x2C69, 0x2C6B, 0x2C6D,
+	0x2C6E, 0x2C6F, 0x2C70, 0x2C72, 0x2C75, 0x2C7C, 0x2C7D, 0x2C7E,
+	0x2C7F, 0x2C80, 0x2C82, 0x2C84, 0x2C86, 0x2C88, 0x2C8A, 0x2C8C,
+	0x2C8E, 0x2C90, 0x2C92, 0x2C94, 0x2C96, 0x2C98, 0x2C9A, 0x2C9C,
+	0x2C9E, 0x2CA0, 0x2CA2, 0x2CA4, 0x2CA6, 0x2CA8, 0x2CAA, 0x2CAC,
+	0x2CAE, 0x2CB0, 0x2CB2, 0x2CB4, 0x2CB6, 0x2CB8, 0x2CBA, 0x2CBC,
+	0x2CBE, 0x2CC0, 0x2CC2, 0x2CC4, 0x2CC6, 0x2CC8, 0x2CCA, 0x2CCC,
+	0x2CCE, 0x2CD0, 0x2CD2, 0x2CD4, 0x2CD6, 0x2CD8, 0x2CDA, 0x2CDC,
+	0x2CDE, 0x2CE0, 0x2CE2, 0x2CEB, 0x2CED, 0x2CF2, 0x2D6F, 0x2E9F,
+	0x2EF3, 0x2F00, 0x2F01, 0x2F02, 0x2F03, 0x2F04, 0x2F05, 0x2F06,
+	0x2F07, 0x2F08, 0x2F09, 0x2F0A, 0x2F0B, 0x2F0C, 0x2F0D, 0x2F0E,
+	0x2F0F, 0x2F10, 0x2F11, 0x2F12, 0x2F13, 0x2F14, 0x2F15, 0x2F16,
+	0x2F17, 0x2F18, 0x2F19, 0x2F1A, 0x2F1B, 0x2F1C, 0x2F1D, 0x2F1E,
+	0x2F1F, 0x2F20, 0x2F21, 0x2F22, 0x2F23, 0x2F24, 0x2F25, 0x2F26,
+	0x2F27, 0x2F28, 0x2F29, 0x2F2A, 0x2F2B, 0x2F2C, 0x2F2D, 0x2F2E,
+	0x2F2F, 0x2F30, 0x2F31, 0x2F32, 0x2F33, 0x2F34, 0x2F35, 0x2F36,
+	0x2F37, 0x2F38, 0x2F39, 0x2F3A, 0x2F3B, 0x2F3C, 0x2F3D, 0x2F3E,
+	0x2F3F, 0x2F40, 0x2F41, 0x2F42, 0x2F43, 0x2F44, 0x2F45, 0x2F46,
+	0x2F47, 0x2F48, 0x2F49, 0x2F4A, 0x2F4B, 0x2F4C, 0x2F4D, 0x2F4E,
+	0x2F4F, 0x2F50, 0x2F51, 0x2F52, 0x2F53, 0x2F54, 0x2F55, 0x2F56,
+	0x2F57, 0x2F58, 0x2F59, 0x2F5A, 0x2F5B, 0x2F5C, 0x2F5D, 0x2F5E,
+	0x2F5F, 0x2F60, 0x2F61, 0x2F62, 0x2F63, 0x2F64, 0x2F65, 0x2F66,
+	0x2F67, 0x2F68, 0x2F69, 0x2F6A, 0x2F6B, 0x2F6C, 0x2F6D, 0x2F6E,
+	0x2F6F, 0x2F70, 0x2F71, 0x2F72, 0x2F73, 0x2F74, 0x2F75, 0x2F76,
+	0x2F77, 0x2F78, 0x2F79, 0x2F7A, 0x2F7B, 0x2F7C, 0x2F7D, 0x2F7E,
+	0x2F7F, 0x2F80, 0x2F81, 0x2F82, 0x2F83, 0x2F84, 0x2F85, 0x2F86,
+	0x2F87, 0x2F88, 0x2F89, 0x2F8A, 0x2F8B, 0x2F8C, 0x2F8D, 0x2F8E,
+	0x2F8F, 0x2F90, 0x2F91, 0x2F92, 0x2F93, 0x2F94, 0x2F95, 0x2F96,
+	0x2F97, 0x2F98, 0x2F99, 0x2F9A, 0x2F9B, 0x2F9C, 0x2F9D, 0x2F9E,
+	0x2F9F, 0x2FA0, 0x2FA1, 0x2FA2, 0x2FA3, 0x2FA4, 0x2FA5, 0x2FA6,
+	0x2FA7, 0x2FA8, 0x2FA9, 0x2FAA, 0x2FAB, 0x2FAC, 0x2FAD, 0x2FAE,
+	0x2FAF, 0x2FB0, 0x2FB1, 0x2FB2, 0x2FB3, 0x2FB4, 0x2FB5, 0x2FB6,
+	0x2FB7, 0x2FB8, 0x2FB9, 0x2FBA, 0x2FBB, 0x2FBC, 0x2FBD, 0x2FBE,
+	0x2FBF, 0x2FC0, 0x2FC1, 0x2FC2, 0x2FC3, 0x2FC4, 0x2FC5, 0x2FC6,
+	0x2FC7, 0x2FC8, 0x2FC9, 0x2FCA, 0x2FCB, 0x2FCC, 0x2FCD, 0x2FCE,
+	0x2FCF, 0x2FD0, 0x2FD1, 0x2FD2, 0x2FD3, 0x2FD4, 0x2FD5, 0x3000,
+	0x3036, 0x3038, 0x3039, 0x303A, 0x309B, 0x309C, 0x309F, 0x30FF,
+	0x3131, 0x3132, 0x3133, 0x3134, 0x3135, 0x3136, 0x3137, 0x3138,
+	0x3139, 0x313A, 0x313B, 0x313C, 0x313D, 0x313E, 0x313F, 0x3140,
+	0x3141, 0x3142, 0x3143, 0x3144, 0x3145, 0x3146, 0x3147, 0x3148,
+	0x3149, 0x314A, 0x314B, 0x314C, 0x314D, 0x314E, 0x314F, 0x3150,
+	0x3151, 0x3152, 0x3153, 0x3154, 0x3155, 0x3156, 0x3157, 0x3158,
+	0x3159, 0x315A, 0x315B, 0x315C, 0x315D, 0x315E, 0x315F, 0x3160,
+	0x3161, 0x3162, 0x3163, 0x3164, 0x3165, 0x3166, 0x3167, 0x3168,
+	0x3169, 0x316A, 0x316B, 0x316C, 0x316D, 0x316E, 0x316F, 0x3170,
+	0x3171, 0x3172, 0x3173, 0x3174, 0x3175, 0x3176, 0x3177, 0x3178,
+	0x3179, 0x317A, 0x317B, 0x317C, 0x317D, 0x317E, 0x317F, 0x3180,
+	0x3181, 0x3182, 0x3183, 0x3184, 0x3185, 0x3186, 0x3187, 0x3188,
+	0x3189, 0x318A, 0x318B, 0x318C, 0x318D, 0x318E, 0x3192, 0x3193,
+	0x3194, 0x3195, 0x3196, 0x3197, 0x3198, 0x3199, 0x319A, 0x319B,
+	0x319C, 0x319D, 0x319E, 0x319F, 0x3200, 0x3201, 0x3202, 0x3203,
+	0x3204, 0x3205, 0x3206, 0x3207, 0x3208, 0x3209, 0x320A, 0x320B,
+	0x320C, 0x320D, 0x320E, 0x320F, 0x3210, 0x3211, 0x3212, 0x3213,
+	0x3214, 0x3215, 0x3216, 0x3217, 0x3218, 0x3219, 0x321A, 0x321B,
+	0x321C, 0x321D, 0x321E, 0x3220, 0x3221, 0x3222, 0x3223, 0x3224,
+	0x3225, 0x3226, 0x3227, 0x3228, 0x3229, 0x322A, 0x322B, 0x322C,
+	0x322D, 0x322E, 0x322F, 0x3230, 0x3231, 0x3232, 0x3233, 0x3234,
+	0x3235, 0x3236, 0x3237, 0x3238, 0x3239, 0x323A, 0x323B, 0x323C,
+	0x323D, 0x323E, 0x323F, 0x3240, 0x3241, 0x3242, 0x3243, 0x3244,
+	0x3245, 0x3246, 0x3247, 0x3250, 0x3251, 0x3252, 0x3253, 0x3254,
+	0x3255, 0x3256, 0x3257, 0x3258, 0x3259, 0x325A, 0x325B, 0x325C,
+	0x325D, 0x325E, 0x325F, 0x3260, 0x3261, 0x3262, 0x3263, 0x3264,
+	0x3265, 0x3266, 0x3267, 0x3268, 0x3269, 0x326A, 0x326B, 0x326C,
+	0x326D, 0x326E, 0x326F, 0x3270, 0x3271, 0x3272, 0x3273, 0x3274,
+	0x3275, 0x3276, 0x3277, 0x3278, 0x3279, 0x327A, 0x327B, 0x327C,
+	0x327D, 0x327E, 0x3280, 0x3281, 0x3282, 0x3283, 0x3284, 0x3285,
+	0x3286, 0x3287, 0x3288, 0x3289, 0x328A, 0x328B, 0x328C, 0x328D,
+	0x328E, 0x328F, 0x3290, 0x3291, 0x3292, 0x3293, 0x3294, 0x3295,
+	0x3296, 0x3297, 0x3298, 0x3299, 0x329A, 0x329B, 0x329C, 0x329D,
+	0x329E, 0x329F, 0x32A0, 0x32A1, 0x32A2, 0x32A3, 0x32A4, 0x32A5,
+	0x32A6, 0x32A7, 0x32A8, 0x32A9, 0x32AA, 0x32AB, 0x32AC, 0x32AD,
+	0x32AE, 0x32AF, 0x32B0, 0x32B1, 0x32B2, 0x32B3, 0x32B4, 0x32B5,
+	0x32B6, 0x32B7, 0x32B8, 0x32B9, 0x32BA, 0x32BB, 0x32BC, 0x32BD,
+	0x32BE, 0x32BF, 0x32C0, 0x32C1, 0x32C2, 0x32C3, 0x32C4, 0x32C5,
+	0x32C6, 0x32C7, 0x32C8, 0x32C9, 0x32CA, 0x32CB, 0x32CC, 0x32CD,
+	0x32CE, 0x32CF, 0x32D0, 0x32D1, 0x32D2, 0x32D3, 0x32D4, 0x32D5,
+	0x32D6, 0x32D7, 0x32D8, 0x32D9, 0x32DA, 0x32DB, 0x32DC, 0x32DD,
+	0x32DE, 0x32DF, 0x32E0, 0x32E1, 0x32E2, 0x32E3, 0x32E4, 0x32E5,
+	0x32E6, 0x32E7, 0x32E8, 0x32E9, 0x32EA, 0x32EB, 0x32EC, 0x32ED,
+	0x32EE, 0x32EF, 0x32F0, 0x32F1, 0x32F2, 0x32F3, 0x32F4, 0x32F5,
+	0x32F6, 0x32F7, 0x32F8, 0x32F9, 0x32FA, 0x32FB, 0x32FC, 0x32FD,
+	0x32FE, 0x32FF, 0x3300, 0x3301, 0x3302, 0x3303, 0x3304, 0x3305,
+	0x3306, 0x3307, 0x3308, 0x3309, 0x330A, 0x330B, 0x330C, 0x330D,
+	0x330E, 0x330F, 0x3310, 0x3311, 0x3312, 0x3313, 0x3314, 0x3315,
+	0x3316, 0x3317, 0x3318, 0x3319, 0x331A, 0x331B, 0x331C, 0x331D,
+	0x331E, 0x331F, 0x3320, 0x3321, 0x3322, 0x3323, 0x3324, 0x3325,
+	0x3326, 0x3327, 0x3328, 0x3329, 0x332A, 0x332B, 0x332C, 0x332D,
+	0x332E, 0x332F, 0x3330, 0x3331, 0x3332, 0x3333, 0x3334, 0x3335,
+	0x3336, 0x3337, 0x3338, 0x3339, 0x333A, 0x333B, 0x333C, 0x333D,
+	0x333E, 0x333F, 0x3340, 0x3341, 0x3342, 0x3343, 0x3344, 0x3345,
+	0x3346, 0x3347, 0x3348, 0x3349, 0x334A, 0x334B, 0x334C, 0x334D,
+	0x334E, 0x334F, 0x3350, 0x3351, 0x3352, 0x3353, 0x3354, 0x3355,
+	0x3356, 0x3357, 0x3358, 0x3359, 0x335A, 0x335B, 0x335C, 0x335D,
+	0x335E, 0x335F, 0x3360, 0x3361, 0x3362, 0x3363, 0x3364, 0x3365,
+	0x3366, 0x3367, 0x3368, 0x3369, 0x336A, 0x336B, 0x336C, 0x336D,
+	0x336E, 0x336F, 0x3370, 0x3371, 0x3372, 0x3373, 0x3374, 0x3375,
+	0x3376, 0x3377, 0x3378, 0x3379, 0x337A, 0x337B, 0x337C, 0x337D,
+	0x337E, 0x337F, 0x3380, 0x3381, 0x3382, 0x3383, 0x3384, 0x3385,
+	0x3386, 0x3387, 0x3388, 0x3389, 0x338A, 0x338B, 0x338C, 0x338D,
+	0x338E, 0x338F, 0x3390, 0x3391, 0x3392, 0x3393, 0x3394, 0x3395,
+	0x3396, 0x3397, 0x3398, 0x3399, 0x339A, 0x339B, 0x339C, 0x339D,
+	0x339E, 0x339F, 0x33A0, 0x33A1, 0x33A2, 0x33A3, 0x33A4, 0x33A5,
+	0x33A6, 0x33A7, 0x33A8, 0x33A9, 0x33AA, 0x33AB, 0x33AC, 0x33AD,
+	0x33AE, 0x33AF, 0x33B0, 0x33B1, 0x33B2, 0x33B3, 0x33B4, 0x33B5,
+	0x33B6, 0x33B7, 0x33B8, 0x33B9, 0x33BA, 0x33BB, 0x33BC, 0x33BD,
+	0x33BE, 0x33BF, 0x33C0, 0x33C1, 0x33C2, 0x33C3, 0x33C4, 0x33C5,
+	0x33C6, 0x33C7, 0x33C8, 0x33C9, 0x33CA, 0x33CB, 0x33CC, 0x33CD,
+	0x33CE, 0x33CF, 0x33D0, 0x33D1, 0x33D2, 0x33D3, 0x33D4, 0x33D5,
+	0x33D6, 0x33D7, 0x33D8, 0x33D9, 0x33DA, 0x33DB, 0x33DC, 0x33DD,
+	0x33DE, 0x33DF, 0x33E0, 0x33E1, 0x33E2, 0x33E3, 0x33E4, 0x33E5,
+	0x33E6, 0x33E7, 0x33E8, 0x33E9, 0x33EA, 0x33EB, 0x33EC, 0x33ED,
+	0x33EE, 0x33EF, 0x33F0, 0x33F1, 0x33F2, 0x33F3, 0x33F4, 0x33F5,
+	0x33F6, 0x33F7, 0x33F8, 0x33F9, 0x33FA, 0x33FB, 0x33FC, 0x33FD,
+	0x33FE, 0x33FF, 0xA640, 0xA642, 0xA644, 0xA646, 0xA648, 0xA64A,
+	0xA64C, 0xA64E, 0xA650, 0xA652, 0xA654, 0xA656, 0xA658, 0xA65A,
+	0xA65C, 0xA65E, 0xA660, 0xA662, 0xA664, 0xA666, 0xA668, 0xA66A,
+	0xA66C, 0xA680, 0xA682, 0xA684, 0xA686, 0xA688, 0xA68A, 0xA68C,
+	0xA68E, 0xA690, 0xA692, 0xA694, 0xA696, 0xA698, 0xA69A, 0xA69C,
+	0xA69D, 0xA722, 0xA724, 0xA726, 0xA728, 0xA72A, 0xA72C, 0xA72E,
+	0xA732, 0xA734, 0xA736, 0xA738, 0xA73A, 0xA73C, 0xA73E, 0xA740,
+	0xA742, 0xA744, 0xA746, 0xA748, 0xA74A, 0xA74C, 0xA74E, 0xA750,
+	0xA752, 0xA754, 0xA756, 0xA758, 0xA75A, 0xA75C, 0xA75E, 0xA760,
+	0xA762, 0xA764, 0xA766, 0xA768, 0xA76A, 0xA76C, 0xA76E, 0xA770,
+	0xA779, 0xA77B, 0xA77D, 0xA77E, 0xA780, 0xA782, 0xA784, 0xA786,
+	0xA78B, 0xA78D, 0xA790, 0xA792, 0xA796, 0xA798, 0xA79A, 0xA79C,
+	0xA79E, 0xA7A0, 0xA7A2, 0xA7A4, 0xA7A6, 0xA7A8, 0xA7AA, 0xA7AB,
+	0xA7AC, 0xA7AD, 0xA7AE, 0xA7B0, 0xA7B1, 0xA7B2, 0xA7B3, 0xA7B4,
+	0xA7B6, 0xA7B8, 0xA7BA, 0xA7BC, 0xA7BE, 0xA7C0, 0xA7C2, 0xA7C4,
+	0xA7C5, 0xA7C6, 0xA7C7, 0xA7C9, 0xA7D0, 0xA7D6, 0xA7D8, 0xA7F2,
+	0xA7F3, 0xA7F4, 0xA7F5, 0xA7F8, 0xA7F9, 0xAB5C, 0xAB5D, 0xAB5E,
+	0xAB5F, 0xAB69, 0xAB70, 0xAB71, 0xAB72, 0xAB73, 0xAB74, 0xAB75,
+	0xAB76, 0xAB77, 0xAB78, 0xAB79, 0xAB7A, 0xAB7B, 0xAB7C, 0xAB7D,
+	0xAB7E, 0xAB7F, 0xAB80, 0xAB81, 0xAB82, 0xAB83, 0xAB84, 0xAB85,
+	0xAB86, 0xAB87, 0xAB88, 0xAB89, 0xAB8A, 0xAB8B, 0xAB8C, 0xAB8D,
+	0xAB8E, 0xAB8F, 0xAB90, 0xAB91, 0xAB92, 0xAB93, 0xAB94, 0xAB95,
+	0xAB96, 0xAB97, 0xAB98, 0xAB99, 0xAB9A, 0xAB9B, 0xAB9C, 0xAB9D,
+	0xAB9E, 0xAB9F, 0xABA0, 0xABA1, 0xABA2, 0xABA3, 0xABA4, 0xABA5,
+	0xABA6, 0xABA7, 0xABA8, 0xABA9, 0xABAA, 0xABAB, 0xABAC, 0xABAD,
+	0xABAE, 0xABAF, 0xABB0, 0xABB1, 0xABB2, 0xABB3, 0xABB4, 0xABB5,
+	0xABB6, 0xABB7, 0xABB8, 0xABB9, 0xABBA, 0xABBB, 0xABBC, 0xABBD,
+	0xABBE, 0xABBF, 0xF900, 0xF901, 0xF902, 0xF903, 0xF904, 0xF905,
+	0xF906, 0xF907, 0xF908, 0xF909, 0xF90A, 0xF90B, 0xF90C, 0xF90D,
+	0xF90E, 0xF90F, 0xF910, 0xF911, 0xF912, 0xF913, 0xF914, 0xF915,
+	0xF916, 0xF917, 0xF918, 0xF919, 0xF91A, 0xF91B, 0xF91C, 0xF91D,
+	0xF91E, 0xF91F, 0xF920, 0xF921, 0xF922, 0xF923, 0xF924, 0xF925,
+	0xF926, 0xF927, 0xF928, 0xF929, 0xF92A, 0xF92B, 0xF92C, 0xF92D,
+	0xF92E, 0xF92F, 0xF930, 0xF931, 0xF932, 0xF933, 0xF934, 0xF935,
+	0xF936, 0xF937, 0xF938, 0xF939, 0xF93A, 0xF93B, 0xF93C, 0xF93D,
+	0xF93E, 0xF93F, 0xF940, 0xF941, 0xF942, 0xF943, 0xF944, 0xF945,
+	0xF946, 0xF947, 0xF948, 0xF949, 0xF94A, 0xF94B, 0xF94C, 0xF94D,
+	0xF94E, 0xF94F, 0xF950, 0xF951, 0xF952, 0xF953, 0xF954, 0xF955,
+	0xF956, 0xF957, 0xF958, 0xF959, 0xF95A, 0xF95B, 0xF95C, 0xF95D,
+	0xF95E, 0xF95F, 0xF960, 0xF961, 0xF962, 0xF963, 0xF964, 0xF965,
+	0xF966, 0xF967, 0xF968, 0xF969, 0xF96A, 0xF96B, 0xF96C, 0xF96D,
+	0xF96E, 0xF96F, 0xF970, 0xF971, 0xF972, 0xF973, 0xF974, 0xF975,
+	0xF976, 0xF977, 0xF978, 0xF979, 0xF97A, 0xF97B, 0xF97C, 0xF97D,
+	0xF97E, 0xF97F, 0xF980, 0xF981, 0xF982, 0xF983, 0xF984, 0xF985,
+	0xF986, 0xF987, 0xF988, 0xF989, 0xF98A, 0xF98B, 0xF98C, 0xF98D,
+	0xF98E, 0xF98F, 0xF990, 0xF991, 0xF992, 0xF993, 0xF994, 0xF995,
+	0xF996, 0xF997, 0xF998, 0xF999, 0xF99A, 0xF99B, 0xF99C, 0xF99D,
+	0xF99E, 0xF99F, 0xF9A0, 0xF9A1, 0xF9A2, 0xF9A3, 0xF9A4, 0xF9A5,
+	0xF9A6, 0xF9A7, 0xF9A8, 0xF9A9, 0xF9AA, 0xF9AB, 0xF9AC, 0xF9AD,
+	0xF9AE, 0xF9AF, 0xF9B0, 0xF9B1, 0xF9B2, 0xF9B3, 0xF9B4, 0xF9B5,
+	0xF9B6, 0xF9B7, 0xF9B8, 0xF9B9, 0xF9BA, 0xF9BB, 0xF9BC, 0xF9BD,
+	0xF9BE, 0xF9BF, 0xF9C0, 0xF9C1, 0xF9C2, 0xF9C3, 0xF9C4, 0xF9C5,
+	0xF9C6, 0xF9C7, 0xF9C8, 0xF9C9, 0xF9CA, 0xF9CB, 0xF9CC, 0xF9CD,
+	0xF9CE, 0xF9CF, 0xF9D0, 0xF9D1, 0xF9D2, 0xF9D3, 0xF9D4, 0xF9D5,
+	0xF9D6, 0xF9D7, 0xF9D8, 0xF9D9, 0xF9DA, 0xF9DB, 0xF9DC, 0xF9DD,
+	0xF9DE, 0xF9DF, 0xF9E0, 0xF9E1, 0xF9E2, 0xF9E3, 0xF9E4, 0xF9E5,
+	0xF9E6, 0xF9E7, 0xF9E8, 0xF9E9, 0xF9EA, 0xF9EB, 0xF9EC, 0xF9ED,
+	0xF9EE, 0xF9EF, 0xF9F0, 0xF9F1, 0xF9F2, 0xF9F3, 0xF9F4, 0xF9F5,
+	0xF9F6, 0xF9F7, 0xF9F8, 0xF9F9, 0xF9FA, 0xF9FB, 0xF9FC, 0xF9FD,
+	0xF9FE, 0xF9FF, 0xFA00, 0xFA01, 0xFA02, 0xFA03, 0xFA04, 0xFA05,
+	0xFA06, 0xFA07, 0xFA08, 0xFA09, 0xFA0A, 0xFA0B, 0xFA0C, 0xFA0D,
+	0xFA10, 0xFA12, 0xFA15, 0xFA16, 0xFA17, 0xFA18, 0xFA19, 0xFA1A,
+	0xFA1B, 0xFA1C, 0xFA1D, 0xFA1E, 0xFA20, 0xFA22, 0xFA25, 0xFA26,
+	0xFA2A, 0xFA2B, 0xFA2C, 0xFA2D, 0xFA2E, 0xFA2F, 0xFA30, 0xFA31,
+	0xFA32, 0xFA33, 0xFA34, 0xFA35, 0xFA36, 0xFA37, 0xFA38, 0xFA39,
+	0xFA3A, 0xFA3B, 0xFA3C, 0xFA3D, 0xFA3E, 0xFA3F, 0xFA40, 0xFA41,
+	0xFA42, 0xFA43, 0xFA44, 0xFA45, 0xFA46, 0xFA47, 0xFA48, 0xFA49,
+	0xFA4A, 0xFA4B, 0xFA4C, 0xFA4D, 0xFA4E, 0xFA4F, 0xFA50, 0xFA51,
+	0xFA52, 0xFA53, 0xFA54, 0xFA55, 0xFA56, 0xFA57, 0xFA58, 0xFA59,
+	0xFA5A, 0xFA5B, 0xFA5C, 0xFA5D, 0xFA5E, 0xFA5F, 0xFA60, 0xFA61,
+	0xFA62, 0xFA63, 0xFA64, 0xFA65, 0xFA66, 0xFA67, 0xFA68, 0xFA69,
+	0xFA6A, 0xFA6B, 0xFA6C, 0xFA6D, 0xFA70, 0xFA71, 0xFA72, 0xFA73,
+	0xFA74, 0xFA75, 0xFA76, 0xFA77, 0xFA78, 0xFA79, 0xFA7A, 0xFA7B,
+	0xFA7C, 0xFA7D, 0xFA7E, 0xFA7F, 0xFA80, 0xFA81, 0xFA82, 0xFA83,
+	0xFA84, 0xFA85, 0xFA86, 0xFA87, 0xFA88, 0xFA89, 0xFA8A, 0xFA8B,
+	0xFA8C, 0xFA8D, 0xFA8E, 0xFA8F, 0xFA90, 0xFA91, 0xFA92, 0xFA93,
+	0xFA94, 0xFA95, 0xFA96, 0xFA97, 0xFA98, 0xFA99, 0xFA9A, 0xFA9B,
+	0xFA9C, 0xFA9D, 0xFA9E, 0xFA9F, 0xFAA0, 0xFAA1, 0xFAA2, 0xFAA3,
+	0xFAA4, 0xFAA5, 0xFAA6, 0xFAA7, 0xFAA8, 0xFAA9, 0xFAAA, 0xFAAB,
+	0xFAAC, 0xFAAD, 0xFAAE, 0xFAAF, 0xFAB0, 0xFAB1, 0xFAB2, 0xFAB3,
+	0xFAB4, 0xFAB5, 0xFAB6, 0xFAB7, 0xFAB8, 0xFAB9, 0xFABA, 0xFABB,
+	0xFABC, 0xFABD, 0xFABE, 0xFABF, 0xFAC0, 0xFAC1, 0xFAC2, 0xFAC3,
+	0xFAC4, 0xFAC5, 0xFAC6, 0xFAC7, 0xFAC8, 0xFAC9, 0xFACA, 0xFACB,
+	0xFACC, 0xFACD, 0xFACE, 0xFACF, 0xFAD0, 0xFAD1, 0xFAD2, 0xFAD3,
+	0xFAD4, 0xFAD5, 0xFAD6, 0xFAD7, 0xFAD8, 0xFAD9, 0xFB00, 0xFB01,
+	0xFB02, 0xFB03, 0xFB04, 0xFB05, 0xFB06, 0xFB13, 0xFB14, 0xFB15,
+	0xFB16, 0xFB17, 0xFB20, 0xFB21, 0xFB22, 0xFB23, 0xFB24, 0xFB25,
+	0xFB26, 0xFB27, 0xFB28, 0xFB29, 0xFB4F, 0xFB50, 0xFB51, 0xFB52,
+	0xFB53, 0xFB54, 0xFB55, 0xFB56, 0xFB57, 0xFB58, 0xFB59, 0xFB5A,
+	0xFB5B, 0xFB5C, 0xFB5D, 0xFB5E, 0xFB5F, 0xFB60, 0xFB61, 0xFB62,
+	0xFB63, 0xFB64, 0xFB65, 0xFB66, 0xFB67, 0xFB68, 0xFB69, 0xFB6A,
+	0xFB6B, 0xFB6C, 0xFB6D, 0xFB6E, 0xFB6F, 0xFB70, 0xFB71, 0xFB72,
+	0xFB73, 0xFB74, 0xFB75, 0xFB76, 0xFB77, 0xFB78, 0xFB79, 0xFB7A,
+	0xFB7B, 0xFB7C, 0xFB7D, 0xFB7E, 0xFB7F, 0xFB80, 0xFB81, 0xFB82,
+	0xFB83, 0xFB84, 0xFB85, 0xFB86, 0xFB87, 0xFB88, 0xFB89, 0xFB8A,
+	0xFB8B, 0xFB8C, 0xFB8D, 0xFB8E, 0xFB8F, 0xFB90, 0xFB91, 0xFB92,
+	0xFB93, 0xFB94, 0xFB95, 0xFB96, 0xFB97, 0xFB98, 0xFB99, 0xFB9A,
+	0xFB9B, 0xFB9C, 0xFB9D, 0xFB9E, 0xFB9F, 0xFBA0, 0xFBA1, 0xFBA2,
+	0xFBA3, 0xFBA4, 0xFBA5, 0xFBA6, 0xFBA7, 0xFBA8, 0xFBA9, 0xFBAA,
+	0xFBAB, 0xFBAC, 0xFBAD, 0xFBAE, 0xFBAF, 0xFBB0, 0xFBB1, 0xFBD3,
+	0xFBD4, 0xFBD5, 0xFBD6, 0xFBD7, 0xFBD8, 0xFBD9, 0xFBDA, 0xFBDB,
+	0xFBDC, 0xFBDD, 0xFBDE, 0xFBDF, 0xFBE0, 0xFBE1, 0xFBE2, 0xFBE3,
+	0xFBE4, 0xFBE5, 0xFBE6, 0xFBE7, 0xFBE8, 0xFBE9, 0xFBEA, 0xFBEB,
+	0xFBEC, 0xFBED, 0xFBEE, 0xFBEF, 0xFBF0, 0xFBF1, 0xFBF2, 0xFBF3,
+	0xFBF4, 0xFBF5, 0xFBF6, 0xFBF7, 0xFBF8, 0xFBF9, 0xFBFA, 0xFBFB,
+	0xFBFC, 0xFBFD, 0xFBFE, 0xFBFF, 0xFC00, 0xFC01, 0xFC02, 0xFC03,
+	0xFC04, 0xFC05, 0xFC06, 0xFC07, 0xFC08, 0xFC09, 0xFC0A, 0xFC0B,
+	0xFC0C, 0xFC0D, 0xFC0E, 0xFC0F, 0xFC10, 0xFC11, 0xFC12, 0xFC13,
+	0xFC14, 0xFC15, 0xFC16, 0xFC17, 0xFC18, 0xFC19, 0xFC1A, 0xFC1B,
+	0xFC1C, 0xFC1D, 0xFC1E, 0xFC1F, 0xFC20, 0xFC21, 0xFC22, 0xFC23,
+	0xFC24, 0xFC25, 0xFC26, 0xFC27, 0xFC28, 0xFC29, 0xFC2A, 0xFC2B,
+	0xFC2C, 0xFC2D, 0xFC2E, 0xFC2F, 0xFC30, 0xFC31, 0xFC32, 0xFC33,
+	0xFC34, 0xFC35, 0xFC36, 0xFC37, 0xFC38, 0xFC39, 0xFC3A, 0xFC3B,
+	0xFC3C, 0xFC3D, 0xFC3E, 0xFC3F, 0xFC40, 0xFC41, 0xFC42, 0xFC43,
+	0xFC44, 0xFC45, 0xFC46, 0xFC47, 0xFC48, 0xFC49, 0xFC4A, 0xFC4B,
+	0xFC4C, 0xFC4D, 0xFC4E, 0xFC4F, 0xFC50, 0xFC51, 0xFC52, 0xFC53,
+	0xFC54, 0xFC55, 0xFC56, 0xFC57, 0xFC58, 0xFC59, 0xFC5A, 0xFC5B,
+	0xFC5C, 0xFC5D, 0xFC5E, 0xFC5F, 0xFC60, 0xFC61, 0xFC62, 0xFC63,
+	0xFC64, 0xFC65, 0xFC66, 0xFC67, 0xFC68, 0xFC69, 0xFC6A, 0xFC6B,
+	0xFC6C, 0xFC6D, 0xFC6E, 0xFC6F, 0xFC70, 0xFC71, 0xFC72, 0xFC73,
+	0xFC74, 0xFC75, 0xFC76, 0xFC77, 0xFC78, 0xFC79, 0xFC7A, 0xFC7B,
+	0xFC7C, 0xFC7D, 0xFC7E, 0xFC7F, 0xFC80, 0xFC81, 0xFC82, 0xFC83,
+	0xFC84, 0xFC85, 0xFC86, 0xFC87, 0xFC88, 0xFC89, 0xFC8A, 0xFC8B,
+	0xFC8C, 0xFC8D, 0xFC8E, 0xFC8F, 0xFC90, 0xFC91, 0xFC92, 0xFC93,
+	0xFC94, 0xFC95, 0xFC96, 0xFC97, 0xFC98, 0xFC99, 0xFC9A, 0xFC9B,
+	0xFC9C, 0xFC9D, 0xFC9E, 0xFC9F, 0xFCA0, 0xFCA1, 0xFCA2, 0xFCA3,
+	0xFCA4, 0xFCA5, 0xFCA6, 0xFCA7, 0xFCA8, 0xFCA9, 0xFCAA, 0xFCAB,
+	0xFCAC, 0xFCAD, 0xFCAE, 0xFCAF, 0xFCB0, 0xFCB1, 0xFCB2, 0xFCB3,
+	0xFCB4, 0xFCB5, 0xFCB6, 0xFCB7, 0xFCB8, 0xFCB9, 0xFCBA, 0xFCBB,
+	0xFCBC, 0xFCBD, 0xFCBE, 0xFCBF, 0xFCC0, 0xFCC1, 0xFCC2, 0xFCC3,
+	0xFCC4, 0xFCC5, 0xFCC6, 0xFCC7, 0xFCC8, 0xFCC9, 0xFCCA, 0xFCCB,
+	0xFCCC, 0xFCCD, 0xFCCE, 0xFCCF, 0xFCD0, 0xFCD1, 0xFCD2, 0xFCD3,
+	0xFCD4, 0xFCD5, 0xFCD6, 0xFCD7, 0xFCD8, 0xFCD9, 0xFCDA, 0xFCDB,
+	0xFCDC, 0xFCDD, 0xFCDE, 0xFCDF, 0xFCE0, 0xFCE1, 0xFCE2, 0xFCE3,
+	0xFCE4, 0xFCE5, 0xFCE6, 0xFCE7, 0xFCE8, 0xFCE9, 0xFCEA, 0xFCEB,
+	0xFCEC, 0xFCED, 0xFCEE, 0xFCEF, 0xFCF0, 0xFCF1, 0xFCF2, 0xFCF3,
+	0xFCF4, 0xFCF5, 0xFCF6, 0xFCF7, 0xFCF8, 0xFCF9, 0xFCFA, 0xFCFB,
+	0xFCFC, 0xFCFD, 0xFCFE, 0xFCFF, 0xFD00, 0xFD01, 0xFD02, 0xFD03,
+	0xFD04, 0xFD05, 0xFD06, 0xFD07, 0xFD08, 0xFD09, 0xFD0A, 0xFD0B,
+	0xFD0C, 0xFD0D, 0xFD0E, 0xFD0F, 0xFD10, 0xFD11, 0xFD12, 0xFD13,
+	0xFD14, 0xFD15, 0xFD16, 0xFD17, 0xFD18, 0xFD19, 0xFD1A, 0xFD1B,
+	0xFD1C, 0xFD1D, 0xFD1E, 0xFD1F, 0xFD20, 0xFD21, 0xFD22, 0xFD23,
+	0xFD24, 0xFD25, 0xFD26, 0xFD27, 0xFD28, 0xFD29, 0xFD2A, 0xFD2B,
+	0xFD2C, 0xFD2D, 0xFD2E, 0xFD2F, 0xFD30, 0xFD31, 0xFD32, 0xFD33,
+	0xFD34, 0xFD35, 0xFD36, 0xFD37, 0xFD38, 0xFD39, 0xFD3A, 0xFD3B,
+	0xFD3C, 0xFD3D, 0xFD50, 0xFD51, 0xFD52, 0xFD53, 0xFD54, 0xFD55,
+	0xFD56, 0xFD57, 0xFD58, 0xFD59, 0xFD5A, 0xFD5B, 0xFD5C, 0xFD5D,
+	0xFD5E, 0xFD5F, 0xFD60, 0xFD61, 0xFD62, 0xFD63, 0xFD64, 0xFD65,
+	0xFD66, 0xFD67, 0xFD68, 0xFD69, 0xFD6A, 0xFD6B, 0xFD6C, 0xFD6D,
+	0xFD6E, 0xFD6F, 0xFD70, 0xFD71, 0xFD72, 0xFD73, 0xFD74, 0xFD75,
+	0xFD76, 0xFD77, 0xFD78, 0xFD79, 0xFD7A, 0xFD7B, 0xFD7C, 0xFD7D,
+	0xFD7E, 0xFD7F, 0xFD80, 0xFD81, 0xFD82, 0xFD83, 0xFD84, 0xFD85,
+	0xFD86, 0xFD87, 0xFD88, 0xFD89, 0xFD8A, 0xFD8B, 0xFD8C, 0xFD8D,
+	0xFD8E, 0xFD8F, 0xFD92, 0xFD93, 0xFD94, 0xFD95, 0xFD96, 0xFD97,
+	0xFD98, 0xFD99, 0xFD9A, 0xFD9B, 0xFD9C, 0xFD9D, 0xFD9E, 0xFD9F,
+	0xFDA0, 0xFDA1, 0xFDA2, 0xFDA3, 0xFDA4, 0xFDA5, 0xFDA6, 0xFDA7,
+	0xFDA8, 0xFDA9, 0xFDAA, 0xFDAB, 0xFDAC, 0xFDAD, 0xFDAE, 0xFDAF,
+	0xFDB0, 0xFDB1, 0xFDB2, 0xFDB3, 0xFDB4, 0xFDB5, 0xFDB6, 0xFDB7,
+	0xFDB8, 0xFDB9, 0xFDBA, 0xFDBB, 0xFDBC, 0xFDBD, 0xFDBE, 0xFDBF,
+	0xFDC0, 0xFDC1, 0xFDC2, 0xFDC3, 0xFDC4, 0xFDC5, 0xFDC6, 0xFDC7,
+	0xFDF0, 0xFDF1, 0xFDF2, 0xFDF3, 0xFDF4, 0xFDF5, 0xFDF6, 0xFDF7,
+	0xFDF8, 0xFDF9, 0xFDFA, 0xFDFB, 0xFDFC, 0xFE10, 0xFE11, 0xFE12,
+	0xFE13, 0xFE14, 0xFE15, 0xFE16, 0xFE17, 0xFE18, 0xFE19, 0xFE20,
+	0xFE21, 0xFE22, 0xFE23, 0xFE24, 0xFE25, 0xFE26, 0xFE27, 0xFE28,
+	0xFE29, 0xFE2A, 0xFE2B, 0xFE2C, 0xFE2D, 0xFE2E, 0xFE2F, 0xFE30,
+	0xFE31, 0xFE32, 0xFE33, 0xFE34, 0xFE35, 0xFE36, 0xFE37, 0xFE38,
+	0xFE39, 0xFE3A, 0xFE3B, 0xFE3C, 0xFE3D, 0xFE3E, 0xFE3F, 0xFE40,
+	0xFE41, 0xFE42, 0xFE43, 0xFE44, 0xFE47, 0xFE48, 0xFE49, 0xFE4A,
+	0xFE4B, 0xFE4C, 0xFE4D, 0xFE4E, 0xFE4F, 0xFE50, 0xFE51, 0xFE52,
+	0xFE54, 0xFE55, 0xFE56, 0xFE57, 0xFE58, 0xFE59, 0xFE5A, 0xFE5B,
+	0xFE5C, 0xFE5D, 0xFE5E, 0xFE5F, 0xFE60, 0xFE61, 0xFE62, 0xFE63,
+	0xFE64, 0xFE65, 0xFE66, 0xFE68, 0xFE69, 0xFE6A, 0xFE6B, 0xFE70,
+	0xFE71, 0xFE72, 0xFE74, 0xFE76, 0xFE77, 0xFE78, 0xFE79, 0xFE7A,
+	0xFE7B, 0xFE7C, 0xFE7D, 0xFE7E, 0xFE7F, 0xFE80, 0xFE81, 0xFE82,
+	0xFE83, 0xFE84, 0xFE85, 0xFE86, 0xFE87, 0xFE88, 0xFE89, 0xFE8A,
+	0xFE8B, 0xFE8C, 0xFE8D, 0xFE8E, 0xFE8F, 0xFE90, 0xFE91, 0xFE92,
+	0xFE93, 0xFE94, 0xFE95, 0xFE96, 0xFE97, 0xFE98, 0xFE99, 0xFE9A,
+	0xFE9B, 0xFE9C, 0xFE9D, 0xFE9E, 0xFE9F, 0xFEA0, 0xFEA1, 0xFEA2,
+	0xFEA3, 0xFEA4, 0xFEA5, 0xFEA6, 0xFEA7, 0xFEA8, 0xFEA9, 0xFEAA,
+	0xFEAB, 0xFEAC, 0xFEAD, 0xFEAE, 0xFEAF, 0xFEB0, 0xFEB1, 0xFEB2,
+	0xFEB3, 0xFEB4, 0xFEB5, 0xFEB6, 0xFEB7, 0xFEB8, 0xFEB9, 0xFEBA,
+	0xFEBB, 0xFEBC, 0xFEBD, 0xFEBE, 0xFEBF, 0xFEC0, 0xFEC1, 0xFEC2,
+	0xFEC3, 0xFEC4, 0xFEC5, 0xFEC6, 0xFEC7, 0xFEC8, 0xFEC9, 0xFECA,
+	0xFECB, 0xFECC, 0xFECD, 0xFECE, 0xFECF, 0xFED0, 0xFED1, 0xFED2,
+	0xFED3, 0xFED4, 0xFED5, 0xFED6, 0xFED7, 0xFED8, 0xFED9, 0xFEDA,
+	0xFEDB, 0xFEDC, 0xFEDD, 0xFEDE, 0xFEDF, 0xFEE0, 0xFEE1, 0xFEE2,
+	0xFEE3, 0xFEE4, 0xFEE5, 0xFEE6, 0xFEE7, 0xFEE8, 0xFEE9, 0xFEEA,
+	0xFEEB, 0xFEEC, 0xFEED, 0xFEEE, 0xFEEF, 0xFEF0, 0xFEF1, 0xFEF2,
+	0xFEF3, 0xFEF4, 0xFEF5, 0xFEF6, 0xFEF7, 0xFEF8, 0xFEF9, 0xFEFA,
+	0xFEFB, 0xFEFC, 0xFF01, 0xFF02, 0xFF03, 0xFF04, 0xFF05, 0xFF06,
+	0xFF07, 0xFF08, 0xFF09, 0xFF0A, 0xFF0B, 0xFF0C, 0xFF0D, 0xFF0E,
+	0xFF0F, 0xFF10, 0xFF11, 0xFF12, 0xFF13, 0xFF14, 0xFF15, 0xFF16,
+	0xFF17, 0xFF18, 0xFF19, 0xFF1A, 0xFF1B, 0xFF1C, 0xFF1D, 0xFF1E,
+	0xFF1F, 0xFF20, 0xFF21, 0xFF22, 0xFF23, 0xFF24, 0xFF25, 0xFF26,
+	0xFF27, 0xFF28, 0xFF29, 0xFF2A, 0xFF2B, 0xFF2C, 0xFF2D, 0xFF2E,
+	0xFF2F, 0xFF30, 0xFF31, 0xFF32, 0xFF33, 0xFF34, 0xFF35, 0xFF36,
+	0xFF37, 0xFF38, 0xFF39, 0xFF3A, 0xFF3B, 0xFF3C, 0xFF3D, 0xFF3E,
+	0xFF3F, 0xFF40, 0xFF41, 0xFF42, 0xFF43, 0xFF44, 0xFF45, 0xFF46,
+	0xFF47, 0xFF48, 0xFF49, 0xFF4A, 0xFF4B, 0xFF4C, 0xFF4D, 0xFF4E,
+	0xFF4F, 0xFF50, 0xFF51, 0xFF52, 0xFF53, 0xFF54, 0xFF55, 0xFF56,
+	0xFF57, 0xFF58, 0xFF59, 0xFF5A, 0xFF5B, 0xFF5C, 0xFF5D, 0xFF5E,
+	0xFF5F, 0xFF60, 0xFF61, 0xFF62, 0xFF63, 0xFF64, 0xFF65, 0xFF66,
+	0xFF67, 0xFF68, 0xFF69, 0xFF6A, 0xFF6B, 0xFF6C, 0xFF6D, 0xFF6E,
+	0xFF6F, 0xFF70, 0xFF71, 0xFF72, 0xFF73, 0xFF74, 0xFF75, 0xFF76,
+	0xFF77, 0xFF78, 0xFF79, 0xFF7A, 0xFF7B, 0xFF7C, 0xFF7D, 0xFF7E,
+	0xFF7F, 0xFF80, 0xFF81, 0xFF82, 0xFF83, 0xFF84, 0xFF85, 0xFF86,
+	0xFF87, 0xFF88, 0xFF89, 0xFF8A, 0xFF8B, 0xFF8C, 0xFF8D, 0xFF8E,
+	0xFF8F, 0xFF90, 0xFF91, 0xFF92, 0xFF93, 0xFF94, 0xFF95, 0xFF96,
+	0xFF97, 0xFF98, 0xFF99, 0xFF9A, 0xFF9B, 0xFF9C, 0xFF9D, 0xFF9E,
+	0xFF9F, 0xFFA0, 0xFFA1, 0xFFA2, 0xFFA3, 0xFFA4, 0xFFA5, 0xFFA6,
+	0xFFA7, 0xFFA8, 0xFFA9, 0xFFAA, 0xFFAB, 0xFFAC, 0xFFAD, 0xFFAE,
+	0xFFAF, 0xFFB0, 0xFFB1, 0xFFB2, 0xFFB3, 0xFFB4, 0xFFB5, 0xFFB6,
+	0xFFB7, 0xFFB8, 0xFFB9, 0xFFBA, 0xFFBB, 0xFFBC, 0xFFBD, 0xFFBE,
+	0xFFC2, 0xFFC3, 0xFFC4, 0xFFC5, 0xFFC6, 0xFFC7, 0xFFCA, 0xFFCB,
+	0xFFCC, 0xFFCD, 0xFFCE, 0xFFCF, 0xFFD2, 0xFFD3, 0xFFD4, 0xFFD5,
+	0xFFD6, 0xFFD7, 0xFFDA, 0xFFDB, 0xFFDC, 0xFFE0, 0xFFE1, 0xFFE2,
+	0xFFE3, 0xFFE4, 0xFFE5, 0xFFE6, 0xFFE8, 0xFFE9, 0xFFEA, 0xFFEB,
+	0xFFEC, 0xFFED, 0xFFEE, 0x10400, 0x10401, 0x10402, 0x10403, 0x10404,
+	0x10405, 0x10406, 0x10407, 0x10408, 0x10409, 0x1040A, 0x1040B, 0x1040C,
+	0x1040D, 0x1040E, 0x1040F, 0x10410, 0x10411, 0x10412, 0x10413, 0x10414,
+	0x10415, 0x10416, 0x10417, 0x10418, 0x10419, 0x1041A, 0x1041B, 0x1041C,
+	0x1041D, 0x1041E, 0x1041F, 0x10420, 0x10421, 0x10422, 0x10423, 0x10424,
+	0x10425, 0x10426, 0x10427, 0x104B0, 0x104B1, 0x104B2, 0x104B3, 0x104B4,
+	0x104B5, 0x104B6, 0x104B7, 0x104B8, 0x104B9, 0x104BA, 0x104BB, 0x104BC,
+	0x104BD, 0x104BE, 0x104BF, 0x104C0, 0x104C1, 0x104C2, 0x104C3, 0x104C4,
+	0x104C5, 0x104C6, 0x104C7, 0x104C8, 0x104C9, 0x104CA, 0x104CB, 0x104CC,
+	0x104CD, 0x104CE, 0x104CF, 0x104D0, 0x104D1, 0x104D2, 0x104D3, 0x10570,
+	0x10571, 0x10572, 0x10573, 0x10574, 0x10575, 0x10576, 0x10577, 0x10578,
+	0x10579, 0x1057A, 0x1057C, 0x1057D, 0x1057E, 0x1057F, 0x10580, 0x10581,
+	0x10582, 0x10583, 0x10584, 0x10585, 0x10586, 0x10587, 0x10588, 0x10589,
+	0x1058A, 0x1058C, 0x1058D, 0x1058E, 0x1058F, 0x10590, 0x10591, 0x10592,
+	0x10594, 0x10595, 0x10781, 0x10782, 0x10783, 0x10784, 0x10785, 0x10787,
+	0x10788, 0x10789, 0x1078A, 0x1078B, 0x1078C, 0x1078D, 0x1078E, 0x1078F,
+	0x10790, 0x10791, 0x10792, 0x10793, 0x10794, 0x10795, 0x10796, 0x10797,
+	0x10798, 0x10799, 0x1079A, 0x1079B, 0x1079C, 0x1079D, 0x1079E, 0x1079F,
+	0x107A0, 0x107A1, 0x107A2, 0x107A3, 0x107A4, 0x107A5, 0x107A6, 0x107A7,
+	0x107A8, 0x107A9, 0x107AA, 0x107AB, 0x107AC, 0x107AD, 0x107AE, 0x107AF,
+	0x107B0, 0x107B2, 0x107B3, 0x107B4, 0x107B5, 0x107B6, 0x107B7, 0x107B8,
+	0x107B9, 0x107BA, 0x10C80, 0x10C81, 0x10C82, 0x10C83, 0x10C84, 0x10C85,
+	0x10C86, 0x10C87, 0x10C88, 0x10C89, 0x10C8A, 0x10C8B, 0x10C8C, 0x10C8D,
+	0x10C8E, 0x10C8F, 0x10C90, 0x10C91, 0x10C92, 0x10C93, 0x10C94, 0x10C95,
+	0x10C96, 0x10C97, 0x10C98, 0x10C99, 0x10C9A, 0x10C9B, 0x10C9C, 0x10C9D,
+	0x10C9E, 0x10C9F, 0x10CA0, 0x10CA1, 0x10CA2, 0x10CA3, 0x10CA4, 0x10CA5,
+	0x10CA6, 0x10CA7, 0x10CA8, 0x10CA9, 0x10CAA, 0x10CAB, 0x10CAC, 0x10CAD,
+	0x10CAE, 0x10CAF, 0x10CB0, 0x10CB1, 0x10CB2, 0x118A0, 0x118A1, 0x118A2,
+	0x118A3, 0x118A4, 0x118A5, 0x118A6, 0x118A7, 0x118A8, 0x118A9, 0x118AA,
+	0x118AB, 0x118AC, 0x118AD, 0x118AE, 0x118AF, 0x118B0, 0x118B1, 0x118B2,
+	0x118B3, 0x118B4, 0x118B5, 0x118B6, 0x118B7, 0x118B8, 0x118B9, 0x118BA,
+	0x118BB, 0x118BC, 0x118BD, 0x118BE, 0x118BF, 0x16E40, 0x16E41, 0x16E42,
+	0x16E43, 0x16E44, 0x16E45, 0x16E46, 0x16E47, 0x16E48, 0x16E49, 0x16E4A,
+	0x16E4B, 0x16E4C, 0x16E4D, 0x16E4E, 0x16E4F, 0x16E50, 0x16E51, 0x16E52,
+	0x16E53, 0x16E54, 0x16E55, 0x16E56, 0x16E57, 0x16E58, 0x16E59, 0x16E5A,
+	0x16E5B, 0x16E5C, 0x16E5D, 0x16E5E, 0x16E5F, 0x1D400, 0x1D401, 0x1D402,
+	0x1D403, 0x1D404, 0x1D405, 0x1D406, 0x1D407, 0x1D408, 0x1D409, 0x1D40A,
+	0x1D40B, 0x1D40C, 0x1D40D, 0x1D40E, 0x1D40F, 0x1D410, 0x1D411, 0x1D412,
+	0x1D413, 0x1D414, 0x1D415, 0x1D416, 0x1D417, 0x1D418, 0x1D419, 0x1D41A,
+	0x1D41B, 0x1D41C, 0x1D41D, 0x1D41E, 0x1D41F, 0x1D420, 0x1D421, 0x1D422,
+	0x1D423, 0x1D424, 0x1D425, 0x1D426, 0x1D427, 0x1D428, 0x1D429, 0x1D42A,
+	0x1D42B, 0x1D42C, 0x1D42D, 0x1D42E, 0x1D42F, 0x1D430, 0x1D431, 0x1D432,
+	0x1D433, 0x1D434, 0x1D435, 0x1D436, 0x1D437, 0x1D438, 0x1D439, 0x1D43A,
+	0x1D43B, 0x1D43C, 0x1D43D, 0x1D43E, 0x1D43F, 0x1D440, 0x1D441, 0x1D442,
+	0x1D443, 0x1D444, 0x1D445, 0x1D446, 0x1D447, 0x1D448, 0x1D449, 0x1D44A,
+	0x1D44B, 0x1D44C, 0x1D44D, 0x1D44E, 0x1D44F, 0x1D450, 0x1D451, 0x1D452,
+	0x1D453, 0x1D454, 0x1D456, 0x1D457, 0x1D458, 0x1D459, 0x1D45A, 0x1D45B,
+	0x1D45C, 0x1D45D, 0x1D45E, 0x1D45F, 0x1D460, 0x1D461, 0x1D462, 0x1D463,
+	0x1D464, 0x1D465, 0x1D466, 0x1D467, 0x1D468, 0x1D469, 0x1D46A, 0x1D46B,
+	0x1D46C, 0x1D46D, 0x1D46E, 0x1D46F, 0x1D470, 0x1D471, 0x1D472, 0x1D473,
+	0x1D474, 0x1D475, 0x1D476, 0x1D477, 0x1D478, 0x1D479, 0x1D47A, 0x1D47B,
+	0x1D47C, 0x1D47D, 0x1D47E, 0x1D47F, 0x1D480, 0x1D481, 0x1D482, 0x1D483,
+	0x1D484, 0x1D485, 0x1D486, 0x1D487, 0x1D488, 0x1D489, 0x1D48A, 0x1D48B,
+	0x1D48C, 0x1D48D, 0x1D48E, 0x1D48F, 0x1D490, 0x1D491, 0x1D492, 0x1D493,
+	0x1D494, 0x1D495, 0x1D496, 0x1D497, 0x1D498, 0x1D499, 0x1D49A, 0x1D49B,
+	0x1D49C, 0x1D49E, 0x1D49F, 0x1D4A2, 0x1D4A5, 0x1D4A6, 0x1D4A9, 0x1D4AA,
+	0x1D4AB, 0x1D4AC, 0x1D4AE, 0x1D4AF, 0x1D4B0, 0x1D4B1, 0x1D4B2, 0x1D4B3,
+	0x1D4B4, 0x1D4B5, 0x1D4B6, 0x1D4B7, 0x1D4B8, 0x1D4B9, 0x1D4BB, 0x1D4BD,
+	0x1D4BE, 0x1D4BF, 0x1D4C0, 0x1D4C1, 0x1D4C2, 0x1D4C3, 0x1D4C5, 0x1D4C6,
+	0x1D4C7, 0x1D4C8, 0x1D4C9, 0x1D4CA, 0x1D4CB, 0x1D4CC, 0x1D4CD, 0x1D4CE,
+	0x1D4CF, 0x1D4D0, 0x1D4D1, 0x1D4D2, 0x1D4D3, 0x1D4D4, 0x1D4D5, 0x1D4D6,
+	0x1D4D7, 0x1D4D8, 0x1D4D9, 0x1D4DA, 0x1D4DB, 0x1D4DC, 0x1D4DD, 0x1D4DE,
+	0x1D4DF, 0x1D4E0, 0x1D4E1, 0x1D4E2, 0x1D4E3, 0x1D4E4, 0x1D4E5, 0x1D4E6,
+	0x1D4E7, 0x1D4E8, 0x1D4E9, 0x1D4EA, 0x1D4EB, 0x1D4EC, 0x1D4ED, 0x1D4EE,
+	0x1D4EF, 0x1D4F0, 0x1D4F1, 0x1D4F2, 0x1D4F3, 0x1D4F4, 0x1D4F5, 0x1D4F6,
+	0x1D4F7, 0x1D4F8, 0x1D4F9, 0x1D4FA, 0x1D4FB, 0x1D4FC, 0x1D4FD, 0x1D4FE,
+	0x1D4FF, 0x1D500, 0x1D501, 0x1D502, 0x1D503, 0x1D504, 0x1D505, 0x1D507,
+	0x1D508, 0x1D509, 0x1D50A, 0x1D50D, 0x1D50E, 0x1D50F, 0x1D510, 0x1D511,
+	0x1D512, 0x1D513, 0x1D514, 0x1D516, 0x1D517, 0x1D518, 0x1D519, 0x1D51A,
+	0x1D51B, 0x1D51C, 0x1D51E, 0x1D51F, 0x1D520, 0x1D521, 0x1D522, 0x1D523,
+	0x1D524, 0x1D525, 0x1D526, 0x1D527, 0x1D528, 0x1D529, 0x1D52A, 0x1D52B,
+	0x1D52C, 0x1D52D, 0x1D52E, 0x1D52F, 0x1D530, 0x1D531, 0x1D532, 0x1D533,
+	0x1D534, 0x1D535, 0x1D536, 0x1D537, 0x1D538, 0x1D539, 0x1D53B, 0x1D53C,
+	0x1D53D, 0x1D53E, 0x1D540, 0x1D541, 0x1D542, 0x1D543, 0x1D544, 0x1D546,
+	0x1D54A, 0x1D54B, 0x1D54C, 0x1D54D, 0x1D54E, 0x1D54F, 0x1D550, 0x1D552,
+	0x1D553, 0x1D554, 0x1D555, 0x1D556, 0x1D557, 0x1D558, 0x1D559, 0x1D55A,
+	0x1D55B, 0x1D55C, 0x1D55D, 0x1D55E, 0x1D55F, 0x1D560, 0x1D561, 0x1D562,
+	0x1D563, 0x1D564, 0x1D565, 0x1D566, 0x1D567, 0x1D568, 0x1D569, 0x1D56A,
+	0x1D56B, 0x1D56C, 0x1D56D, 0x1D56E, 0x1D56F, 0x1D570, 0x1D571, 0x1D572,
+	0x1D573, 0x1D574, 0x1D575, 0x1D576, 0x1D577, 0x1D578, 0x1D579, 0x1D57A,
+	0x1D57B, 0x1D57C, 0x1D57D, 0x1D57E, 0x1D57F, 0x1D580, 0x1D581, 0x1D582,
+	0x1D583, 0x1D584, 0x1D585, 0x1D586, 0x1D587, 0x1D588, 0x1D589, 0x1D58A,
+	0x1D58B, 0x1D58C, 0x1D58D, 0x1D58E, 0x1D58F, 0x1D590, 0x1D591, 0x1D592,
+	0x1D593, 0x1D594, 0x1D595, 0x1D596, 0x1D597, 0x1D598, 0x1D599, 0x1D59A,
+	0x1D59B, 0x1D59C, 0x1D59D, 0x1D59E, 0x1D59F, 0x1D5A0, 0x1D5A1, 0x1D5A2,
+	0x1D5A3, 0x1D5A4, 0x1D5A5, 0x1D5A6, 0x1D5A7, 0x1D5A8, 0x1D5A9, 0x1D5AA,
+	0x1D5AB, 0x1D5AC, 0x1D5AD, 0x1D5AE, 0x1D5AF, 0x1D5B0, 0x1D5B1, 0x1D5B2,
+	0x1D5B3, 0x1D5B4, 0x1D5B5, 0x1D5B6, 0x1D5B7, 0x1D5B8, 0x1D5B9, 0x1D5BA,
+	0x1D5BB, 0x1D5BC, 0x1D5BD, 0x1D5BE, 0x1D5BF, 0x1D5C0, 0x1D5C1, 0x1D5C2,
+	0x1D5C3, 0x1D5C4, 0x1D5C5, 0x1D5C6, 0x1D5C7, 0x1D5C8, 0x1D5C9, 0x1D5CA,
+	0x1D5CB, 0x1D5CC, 0x1D5CD, 0x1D5CE, 0x1D5CF, 0x1D5D0, 0x1D5D1, 0x1D5D2,
+	0x1D5D3, 0x1D5D4, 0x1D5D5, 0x1D5D6, 0x1D5D7, 0x1D5D8, 0x1D5D9, 0x1D5DA,
+	0x1D5DB, 0x1D5DC, 0x1D5DD, 0x1D5DE, 0x1D5DF, 0x1D5E0, 0x1D5E1, 0x1D5E2,
+	0x1D5E3, 0x1D5E4, 0x1D5E5, 0x1D5E6, 0x1D5E7, 0x1D5E8, 0x1D5E9, 0x1D5EA,
+	0x1D5EB, 0x1D5EC, 0x1D5ED, 0x1D5EE, 0x1D5EF, 0x1D5F0, 0x1D5F1, 0x1D5F2,
+	0x1D5F3, 0x1D5F4, 0x1D5F5, 0x1D5F6, 0x1D5F7, 0x1D5F8, 0x1D5F9, 0x1D5FA,
+	0x1D5FB, 0x1D5FC, 0x1D5FD, 0x1D5FE, 0x1D5FF, 0x1D600, 0x1D601, 0x1D602,
+	0x1D603, 0x1D604, 0x1D605, 0x1D606, 0x1D607, 0x1D608, 0x1D609, 0x1D60A,
+	0x1D60B, 0x1D60C, 0x1D60D, 0x1D60E, 0x1D60F, 0x1D610, 0x1D611, 0x1D612,
+	0x1D613, 0x1D614, 0x1D615, 0x1D616, 0x1D617, 0x1D618, 0x1D619, 0x1D61A,
+	0x1D61B, 0x1D61C, 0x1D61D, 0x1D61E, 0x1D61F, 0x1D620, 0x1D621, 0x1D622,
+	0x1D623, 0x1D624, 0x1D625, 0x1D626, 0x1D627, 0x1D628, 0x1D629, 0x1D62A,
+	0x1D62B, 0x1D62C, 0x1D62D, 0x1D62E, 0x1D62F, 0x1D630, 0x1D631, 0x1D632,
+	0x1D633, 0x1D634, 0x1D635, 0x1D636, 0x1D637, 0x1D638, 0x1D639, 0x1D63A,
+	0x1D63B, 0x1D63C, 0x1D63D, 0x1D63E, 0x1D63F, 0x1D640, 0x1D641, 0x1D642,
+	0x1D643, 0x1D644, 0x1D645, 0x1D646, 0x1D647, 0x1D648, 0x1D649, 0x1D64A,
+	0x1D64B, 0x1D64C, 0x1D64D, 0x1D64E, 0x1D64F, 0x1D650, 0x1D651, 0x1D652,
+	0x1D653, 0x1D654, 0x1D655, 0x1D656, 0x1D657, 0x1D658, 0x1D659, 0x1D65A,
+	0x1D65B, 0x1D65C, 0x1D65D, 0x1D65E, 0x1D65F, 0x1D660, 0x1D661, 0x1D662,
+	0x1D663, 0x1D664, 0x1D665, 0x1D666, 0x1D667, 0x1D668, 0x1D669, 0x1D66A,
+	0x1D66B, 0x1D66C, 0x1D66D, 0x1D66E, 0x1D66F, 0x1D670, 0x1D671, 0x1D672,
+	0x1D673, 0x1D674, 0x1D675, 0x1D676, 0x1D677, 0x1D678, 0x1D679, 0x1D67A,
+	0x1D67B, 0x1D67C, 0x1D67D, 0x1D67E, 0x1D67F, 0x1D680, 0x1D681, 0x1D682,
+	0x1D683, 0x1D684, 0x1D685, 0x1D686, 0x1D687, 0x1D688, 0x1D689, 0x1D68A,
+	0x1D68B, 0x1D68C, 0x1D68D, 0x1D68E, 0x1D68F, 0x1D690, 0x1D691, 0x1D692,
+	0x1D693, 0x1D694, 0x1D695, 0x1D696, 0x1D697, 0x1D698, 0x1D699, 0x1D69A,
+	0x1D69B, 0x1D69C, 0x1D69D, 0x1D69E, 0x1D69F, 0x1D6A0, 0x1D6A1, 0x1D6A2,
+	0x1D6A3, 0x1D6A4, 0x1D6A5, 0x1D6A8, 0x1D6A9, 0x1D6AA, 0x1D6AB, 0x1D6AC,
+	0x1D6AD, 0x1D6AE, 0x1D6AF, 0x1D6B0, 0x1D6B1, 0x1D6B2, 0x1D6B3, 0x1D6B4,
+	0x1D6B5, 0x1D6B6, 0x1D6B7, 0x1D6B8, 0x1D6B9, 0x1D6BA, 0x1D6BB, 0x1D6BC,
+	0x1D6BD, 0x1D6BE, 0x1D6BF, 0x1D6C0, 0x1D6C1, 0x1D6C2, 0x1D6C3, 0x1D6C4,
+	0x1D6C5, 0x1D6C6, 0x1D6C7, 0x1D6C8, 0x1D6C9, 0x1D6CA, 0x1D6CB, 0x1D6CC,
+	0x1D6CD, 0x1D6CE, 0x1D6CF, 0x1D6D0, 0x1D6D1, 0x1D6D2, 0x1D6D3, 0x1D6D4,
+	0x1D6D5, 0x1D6D6, 0x1D6D7, 0x1D6D8, 0x1D6D9, 0x1D6DA, 0x1D6DB, 0x1D6DC,
+	0x1D6DD, 0x1D6DE, 0x1D6DF, 0x1D6E0, 0x1D6E1, 0x1D6E2, 0x1D6E3, 0x1D6E4,
+	0x1D6E5, 0x1D6E6, 0x1D6E7, 0x1D6E8, 0x1D6E9, 0x1D6EA, 0x1D6EB, 0x1D6EC,
+	0x1D6ED, 0x1D6EE, 0x1D6EF, 0x1D6F0, 0x1D6F1, 0x1D6F2, 0x1D6F3, 0x1D6F4,
+	0x1D6F5, 0x1D6F6, 0x1D6F7, 0x1D6F8, 0x1D6F9, 0x1D6FA, 0x1D6FB, 0x1D6FC,
+	0x1D6FD, 0x1D6FE, 0x1D6FF, 0x1D700, 0x1D701, 0x1D702, 0x1D703, 0x1D704,
+	0x1D705, 0x1D706, 0x1D707, 0x1D708, 0x1D709, 0x1D70A, 0x1D70B, 0x1D70C,
+	0x1D70D, 0x1D70E, 0x1D70F, 0x1D710, 0x1D711, 0x1D712, 0x1D713, 0x1D714,
+	0x1D715, 0x1D716, 0x1D717, 0x1D718, 0x1D719, 0x1D71A, 0x1D71B, 0x1D71C,
+	0x1D71D, 0x1D71E, 0x1D71F, 0x1D720, 0x1D721, 0x1D722, 0x1D723, 0x1D724,
+	0x1D725, 0x1D726, 0x1D727, 0x1D728, 0x1D729, 0x1D72A, 0x1D72B, 0x1D72C,
+	0x1D72D, 0x1D72E, 0x1D72F, 0x1D730, 0x1D731, 0x1D732, 0x1D733, 0x1D734,
+	0x1D735, 0x1D736, 0x1D737, 0x1D738, 0x1D739, 0x1D73A, 0x1D73B, 0x1D73C,
+	0x1D73D, 0x1D73E, 0x1D73F, 0x1D740, 0x1D741, 0x1D742, 0x1D743, 0x1D744,
+	0x1D745, 0x1D746, 0x1D747, 0x1D748, 0x1D749, 0x1D74A, 0x1D74B, 0x1D74C,
+	0x1D74D, 0x1D74E, 0x1D74F, 0x1D750, 0x1D751, 0x1D752, 0x1D753, 0x1D754,
+	0x1D755, 0x1D756, 0x1D757, 0x1D758, 0x1D759, 0x1D75A, 0x1D75B, 0x1D75C,
+	0x1D75D, 0x1D75E, 0x1D75F, 0x1D760, 0x1D761, 0x1D762, 0x1D763, 0x1D764,
+	0x1D765, 0x1D766, 0x1D767, 0x1D768, 0x1D769, 0x1D76A, 0x1D76B, 0x1D76C,
+	0x1D76D, 0x1D76E, 0x1D76F, 0x1D770, 0x1D771, 0x1D772, 0x1D773, 0x1D774,
+	0x1D775, 0x1D776, 0x1D777, 0x1D778, 0x1D779, 0x1D77A, 0x1D77B, 0x1D77C,
+	0x1D77D, 0x1D77E, 0x1D77F, 0x1D780, 0x1D781, 0x1D782, 0x1D783, 0x1D784,
+	0x1D785, 0x1D786, 0x1D787, 0x1D788, 0x1D789, 0x1D78A, 0x1D78B, 0x1D78C,
+	0x1D78D, 0x1D78E, 0x1D78F, 0x1D790, 0x1D791, 0x1D792, 0x1D793, 0x1D794,
+	0x1D795, 0x1D796, 0x1D797, 0x1D798, 0x1D799, 0x1D79A, 0x1D79B, 0x1D79C,
+	0x1D79D, 0x1D79E, 0x1D79F, 0x1D7A0, 0x1D7A1, 0x1D7A2, 0x1D7A3, 0x1D7A4,
+	0x1D7A5, 0x1D7A6, 0x1D7A7, 0x1D7A8, 0x1D7A9, 0x1D7AA, 0x1D7AB, 0x1D7AC,
+	0x1D7AD, 0x1D7AE, 0x1D7AF, 0x1D7B0, 0x1D7B1, 0x1D7B2, 0x1D7B3, 0x1D7B4,
+	0x1D7B5, 0x1D7B6, 0x1D7B7, 0x1D7B8, 0x1D7B9, 0x1D7BA, 0x1D7BB, 0x1D7BC,
+	0x1D7BD, 0x1D7BE, 0x1D7BF, 0x1D7C0, 0x1D7C1, 0x1D7C2, 0x1D7C3, 0x1D7C4,
+	0x1D7C5, 0x1D7C6, 0x1D7C7, 0x1D7C8, 0x1D7C9, 0x1D7CA, 0x1D7CB, 0x1D7CE,
+	0x1D7CF, 0x1D7D0, 0x1D7D1, 0x1D7D2, 0x1D7D3, 0x1D7D4, 0x1D7D5, 0x1D7D6,
+	0x1D7D7, 0x1D7D8, 0x1D7D9, 0x1D7DA, 0x1D7DB, 0x1D7DC, 0x1D7DD, 0x1D7DE,
+	0x1D7DF, 0x1D7E0, 0x1D7E1, 0x1D7E2, 0x1D7E3, 0x1D7E4, 0x1D7E5, 0x1D7E6,
+	0x1D7E7, 0x1D7E8, 0x1D7E9, 0x1D7EA, 0x1D7EB, 0x1D7EC, 0x1D7ED, 0x1D7EE,
+	0x1D7EF, 0x1D7F0, 0x1D7F1, 0x1D7F2, 0x1D7F3, 0x1D7F4, 0x1D7F5, 0x1D7F6,
+	0x1D7F7, 0x1D7F8, 0x1D7F9, 0x1D7FA, 0x1D7FB, 0x1D7FC, 0x1D7FD, 0x1D7FE,
+	0x1D7FF, 0x1E900, 0x1E901, 0x1E902, 0x1E903, 0x1E904, 0x1E905, 0x1E906,
+	0x1E907, 0x1E908, 0x1E909, 0x1E90A, 0x1E90B, 0x1E90C, 0x1E90D, 0x1E90E,
+	0x1E90F, 0x1E910, 0x1E911, 0x1E912, 0x1E913, 0x1E914, 0x1E915, 0x1E916,
+	0x1E917, 0x1E918, 0x1E919, 0x1E91A, 0x1E91B, 0x1E91C, 0x1E91D, 0x1E91E,
+	0x1E91F, 0x1E920, 0x1E921, 0x1EE00, 0x1EE01, 0x1EE02, 0x1EE03, 0x1EE05,
+	0x1EE06, 0x1EE07, 0x1EE08, 0x1EE09, 0x1EE0A, 0x1EE0B, 0x1EE0C, 0x1EE0D,
+	0x1EE0E, 0x1EE0F, 0x1EE10, 0x1EE11, 0x1EE12, 0x1EE13, 0x1EE14, 0x1EE15,
+	0x1EE16, 0x1EE17, 0x1EE18, 0x1EE19, 0x1EE1A, 0x1EE1B, 0x1EE1C, 0x1EE1D,
+	0x1EE1E, 0x1EE1F, 0x1EE21, 0x1EE22, 0x1EE24, 0x1EE27, 0x1EE29, 0x1EE2A,
+	0x1EE2B, 0x1EE2C, 0x1EE2D, 0x1EE2E, 0x1EE2F, 0x1EE30, 0x1EE31, 0x1EE32,
+	0x1EE34, 0x1EE35, 0x1EE36, 0x1EE37, 0x1EE39, 0x1EE3B, 0x1EE42, 0x1EE47,
+	0x1EE49, 0x1EE4B, 0x1EE4D, 0x1EE4E, 0x1EE4F, 0x1EE51, 0x1EE52, 0x1EE54,
+	0x1EE57, 0x1EE59, 0x1EE5B, 0x1EE5D, 0x1EE5F, 0x1EE61, 0x1EE62, 0x1EE64,
+	0x1EE67, 0x1EE68, 0x1EE69, 0x1EE6A, 0x1EE6C, 0x1EE6D, 0x1EE6E, 0x1EE6F,
+	0x1EE70, 0x1EE71, 0x1EE72, 0x1EE74, 0x1EE75, 0x1EE76, 0x1EE77, 0x1EE79,
+	0x1EE7A, 0x1EE7B, 0x1EE7C, 0x1EE7E, 0x1EE80, 0x1EE81, 0x1EE82, 0x1EE83,
+	0x1EE84, 0x1EE85, 0x1EE86, 0x1EE87, 0x1EE88, 0x1EE89, 0x1EE8B, 0x1EE8C,
+	0x1EE8D, 0x1EE8E, 0x1EE8F, 0x1EE90, 0x1EE91, 0x1EE92, 0x1EE93, 0x1EE94,
+	0x1EE95, 0x1EE96, 0x1EE97, 0x1EE98, 0x1EE99, 0x1EE9A, 0x1EE9B, 0x1EEA1,
+	0x1EEA2, 0x1EEA3, 0x1EEA5, 0x1EEA6, 0x1EEA7, 0x1EEA8, 0x1EEA9, 0x1EEAB,
+	0x1EEAC, 0x1EEAD, 0x1EEAE, 0x1EEAF, 0x1EEB0, 0x1EEB1, 0x1EEB2, 0x1EEB3,
+	0x1EEB4, 0x1EEB5, 0x1EEB6, 0x1EEB7, 0x1EEB8, 0x1EEB9, 0x1EEBA, 0x1EEBB,
+	0x1F100, 0x1F101, 0x1F102, 0x1F103, 0x1F104, 0x1F105, 0x1F106, 0x1F107,
+	0x1F108, 0x1F109, 0x1F10A, 0x1F110, 0x1F111, 0x1F112, 0x1F113, 0x1F114,
+	0x1F115, 0x1F116, 0x1F117, 0x1F118, 0x1F119, 0x1F11A, 0x1F11B, 0x1F11C,
+	0x1F11D, 0x1F11E, 0x1F11F, 0x1F120, 0x1F121, 0x1F122, 0x1F123, 0x1F124,
+	0x1F125, 0x1F126, 0x1F127, 0x1F128, 0x1F129, 0x1F12A, 0x1F12B, 0x1F12C,
+	0x1F12D, 0x1F12E, 0x1F130, 0x1F131, 0x1F132, 0x1F133, 0x1F134, 0x1F135,
+	0x1F136, 0x1F137, 0x1F138, 0x1F139, 0x1F13A, 0x1F13B, 0x1F13C, 0x1F13D,
+	0x1F13E, 0x1F13F, 0x1F140, 0x1F141, 0x1F142, 0x1F143, 0x1F144, 0x1F145,
+	0x1F146, 0x1F147, 0x1F148, 0x1F149, 0x1F14A, 0x1F14B, 0x1F14C, 0x1F14D,
+	0x1F14E, 0x1F14F, 0x1F16A, 0x1F16B, 0x1F16C, 0x1F190, 0x1F200, 0x1F201,
+	0x1F202, 0x1F210, 0x1F211, 0x1F212, 0x1F213, 0x1F214, 0x1F215, 0x1F216,
+	0x1F217, 0x1F218, 0x1F219, 0x1F21A, 0x1F21B, 0x1F21C, 0x1F21D, 0x1F21E,
+	0x1F21F, 0x1F220, 0x1F221, 0x1F222, 0x1F223, 0x1F224, 0x1F225, 0x1F226,
+	0x1F227, 0x1F228, 0x1F229, 0x1F22A, 0x1F22B, 0x1F22C, 0x1F22D, 0x1F22E,
+	0x1F22F, 0x1F230, 0x1F231, 0x1F232, 0x1F233, 0x1F234, 0x1F235, 0x1F236,
+	0x1F237, 0x1F238, 0x1F239, 0x1F23A, 0x1F23B, 0x1F240, 0x1F241, 0x1F242,
+	0x1F243, 0x1F244, 0x1F245, 0x1F246, 0x1F247, 0x1F248, 0x1F250, 0x1F251,
+	0x1FBF0, 0x1FBF1, 0x1FBF2, 0x1FBF3, 0x1FBF4, 0x1FBF5, 0x1FBF6, 0x1FBF7,
+	0x1FBF8, 0x1FBF9, 0x2F800, 0x2F801, 0x2F802, 0x2F803, 0x2F804, 0x2F805,
+	0x2F806, 0x2F807, 0x2F808, 0x2F809, 0x2F80A, 0x2F80B, 0x2F80C, 0x2F80D,
+	0x2F80E, 0x2F80F, 0x2F810, 0x2F811, 0x2F812, 0x2F813, 0x2F814, 0x2F815,
+	0x2F816, 0x2F817, 0x2F818, 0x2F819, 0x2F81A, 0x2F81B, 0x2F81C, 0x2F81D,
+	0x2F81E, 0x2F81F, 0x2F820, 0x2F821, 0x2F822, 0x2F823, 0x2F824, 0x2F825,
+	0x2F826, 0x2F827, 0x2F828, 0x2F829, 0x2F82A, 0x2F82B, 0x2F82C, 0x2F82D,
+	0x2F82E, 0x2F82F, 0x2F830, 0x2F831, 0x2F832, 0x2F833, 0x2F834, 0x2F835,
+	0x2F836, 0x2F837, 0x2F838, 0x2F839, 0x2F83A, 0x2F83B, 0x2F83C, 0x2F83D,
+	0x2F83E, 0x2F83F, 0x2F840, 0x2F841, 0x2F842, 0x2F843, 0x2F844, 0x2F845,
+	0x2F846, 0x2F847, 0x2F848, 0x2F849, 0x2F84A, 0x2F84B, 0x2F84C, 0x2F84D,
+	0x2F84E, 0x2F84F, 0x2F850, 0x2F851, 0x2F852, 0x2F853, 0x2F854, 0x2F855,
+	0x2F856, 0x2F857, 0x2F858, 0x2F859, 0x2F85A, 0x2F85B, 0x2F85C, 0x2F85D,
+	0x2F85E, 0x2F85F, 0x2F860, 0x2F861, 0x2F862, 0x2F863, 0x2F864, 0x2F865,
+	0x2F866, 0x2F867, 0x2F868, 0x2F869, 0x2F86A, 0x2F86B, 0x2F86C, 0x2F86D,
+	0x2F86E, 0x2F86F, 0x2F870, 0x2F871, 0x2F872, 0x2F873, 0x2F874, 0x2F875,
+	0x2F876, 0x2F877, 0x2F878, 0x2F879, 0x2F87A, 0x2F87B, 0x2F87C, 0x2F87D,
+	0x2F87E, 0x2F87F, 0x2F880, 0x2F881, 0x2F882, 0x2F883, 0x2F884, 0x2F885,
+	0x2F886, 0x2F887, 0x2F888, 0x2F889, 0x2F88A, 0x2F88B, 0x2F88C, 0x2F88D,
+	0x2F88E, 0x2F88F, 0x2F890, 0x2F891, 0x2F892, 0x2F893, 0x2F894, 0x2F895,
+	0x2F896, 0x2F897, 0x2F898, 0x2F899, 0x2F89A, 0x2F89B, 0x2F89C, 0x2F89D,
+	0x2F89E, 0x2F89F, 0x2F8A0, 0x2F8A1, 0x2F8A2, 0x2F8A3, 0x2F8A4, 0x2F8A5,
+	0x2F8A6, 0x2F8A7, 0x2F8A8, 0x2F8A9, 0x2F8AA, 0x2F8AB, 0x2F8AC, 0x2F8AD,
+	0x2F8AE, 0x2F8AF, 0x2F8B0, 0x2F8B1, 0x2F8B2, 0x2F8B3, 0x2F8B4, 0x2F8B5,
+	0x2F8B6, 0x2F8B7, 0x2F8B8, 0x2F8B9, 0x2F8BA, 0x2F8BB, 0x2F8BC, 0x2F8BD,
+	0x2F8BE, 0x2F8BF, 0x2F8C0, 0x2F8C1, 0x2F8C2, 0x2F8C3, 0x2F8C4, 0x2F8C5,
+	0x2F8C6, 0x2F8C7, 0x2F8C8, 0x2F8C9, 0x2F8CA, 0x2F8CB, 0x2F8CC, 0x2F8CD,
+	0x2F8CE, 0x2F8CF, 0x2F8D0, 0x2F8D1, 0x2F8D2, 0x2F8D3, 0x2F8D4, 0x2F8D5,
+	0x2F8D6, 0x2F8D7, 0x2F8D8, 0x2F8D9, 0x2F8DA, 0x2F8DB, 0x2F8DC, 0x2F8DD,
+	0x2F8DE, 0x2F8DF, 0x2F8E0, 0x2F8E1, 0x2F8E2, 0x2F8E3, 0x2F8E4, 0x2F8E5,
+	0x2F8E6, 0x2F8E7, 0x2F8E8, 0x2F8E9, 0x2F8EA, 0x2F8EB, 0x2F8EC, 0x2F8ED,
+	0x2F8EE, 0x2F8EF, 0x2F8F0, 0x2F8F1, 0x2F8F2, 0x2F8F3, 0x2F8F4, 0x2F8F5,
+	0x2F8F6, 0x2F8F7, 0x2F8F8, 0x2F8F9, 0x2F8FA, 0x2F8FB, 0x2F8FC, 0x2F8FD,
+	0x2F8FE, 0x2F8FF, 0x2F900, 0x2F901, 0x2F902, 0x2F903, 0x2F904, 0x2F905,
+	0x2F906, 0x2F907, 0x2F908, 0x2F909, 0x2F90A, 0x2F90B, 0x2F90C, 0x2F90D,
+	0x2F90E, 0x2F90F, 0x2F910, 0x2F911, 0x2F912, 0x2F913, 0x2F914, 0x2F915,
+	0x2F916, 0x2F917, 0x2F918, 0x2F919, 0x2F91A, 0x2F91B, 0x2F91C, 0x2F91D,
+	0x2F91E, 0x2F91F, 0x2F920, 0x2F921, 0x2F922, 0x2F923, 0x2F924, 0x2F925,
+	0x2F926, 0x2F927, 0x2F928, 0x2F929, 0x2F92A, 0x2F92B, 0x2F92C, 0x2F92D,
+	0x2F92E, 0x2F92F, 0x2F930, 0x2F931, 0x2F932, 0x2F933, 0x2F934, 0x2F935,
+	0x2F936, 0x2F937, 0x2F938, 0x2F939, 0x2F93A, 0x2F93B, 0x2F93C, 0x2F93D,
+	0x2F93E, 0x2F93F, 0x2F940, 0x2F941, 0x2F942, 0x2F943, 0x2F944, 0x2F945,
+	0x2F946, 0x2F947, 0x2F948, 0x2F949, 0x2F94A, 0x2F94B, 0x2F94C, 0x2F94D,
+	0x2F94E, 0x2F94F, 0x2F950, 0x2F951, 0x2F952, 0x2F953, 0x2F954, 0x2F955,
+	0x2F956, 0x2F957, 0x2F958, 0x2F959, 0x2F95A, 0x2F95B, 0x2F95C, 0x2F95D,
+	0x2F95E, 0x2F95F, 0x2F960, 0x2F961, 0x2F962, 0x2F963, 0x2F964, 0x2F965,
+	0x2F966, 0x2F967, 0x2F968, 0x2F969, 0x2F96A, 0x2F96B, 0x2F96C, 0x2F96D,
+	0x2F96E, 0x2F96F, 0x2F970, 0x2F971, 0x2F972, 0x2F973, 0x2F974, 0x2F975,
+	0x2F976, 0x2F977, 0x2F978, 0x2F979, 0x2F97A, 0x2F97B, 0x2F97C, 0x2F97D,
+	0x2F97E, 0x2F97F, 0x2F980, 0x2F981, 0x2F982, 0x2F983, 0x2F984, 0x2F985,
+	0x2F986, 0x2F987, 0x2F988, 0x2F989, 0x2F98A, 0x2F98B, 0x2F98C, 0x2F98D,
+	0x2F98E, 0x2F98F, 0x2F990, 0x2F991, 0x2F992, 0x2F993, 0x2F994, 0x2F995,
+	0x2F996, 0x2F997, 0x2F998, 0x2F999, 0x2F99A, 0x2F99B, 0x2F99C, 0x2F99D,
+	0x2F99E, 0x2F99F, 0x2F9A0, 0x2F9A1, 0x2F9A2, 0x2F9A3, 0x2F9A4, 0x2F9A5,
+	0x2F9A6, 0x2F9A7, 0x2F9A8, 0x2F9A9, 0x2F9AA, 0x2F9AB, 0x2F9AC, 0x2F9AD,
+	0x2F9AE, 0x2F9AF, 0x2F9B0, 0x2F9B1, 0x2F9B2, 0x2F9B3, 0x2F9B4, 0x2F9B5,
+	0x2F9B6, 0x2F9B7, 0x2F9B8, 0x2F9B9, 0x2F9BA, 0x2F9BB, 0x2F9BC, 0x2F9BD,
+	0x2F9BE, 0x2F9BF, 0x2F9C0, 0x2F9C1, 0x2F9C2, 0x2F9C3, 0x2F9C4, 0x2F9C5,
+	0x2F9C6, 0x2F9C7, 0x2F9C8, 0x2F9C9, 0x2F9CA, 0x2F9CB, 0x2F9CC, 0x2F9CD,
+	0x2F9CE, 0x2F9CF, 0x2F9D0, 0x2F9D1, 0x2F9D2, 0x2F9D3, 0x2F9D4, 0x2F9D5,
+	0x2F9D6, 0x2F9D7, 0x2F9D8, 0x2F9D9, 0x2F9DA, 0x2F9DB, 0x2F9DC, 0x2F9DD,
+	0x2F9DE, 0x2F9DF, 0x2F9E0, 0x2F9E1, 0x2F9E2, 0x2F9E3, 0x2F9E4, 0x2F9E5,
+	0x2F9E6, 0x2F9E7, 0x2F9E8, 0x2F9E9, 0x2F9EA, 0x2F9EB, 0x2F9EC, 0x2F9ED,
+	0x2F9EE, 0x2F9EF, 0x2F9F0, 0x2F9F1, 0x2F9F2, 0x2F9F3, 0x2F9F4, 0x2F9F5,
+	0x2F9F6, 0x2F9F7, 0x2F9F8, 0x2F9F9, 0x2F9FA, 0x2F9FB, 0x2F9FC, 0x2F9FD,
+	0x2F9FE, 0x2F9FF, 0x2FA00, 0x2FA01, 0x2FA02, 0x2FA03, 0x2FA04, 0x2FA05,
+	0x2FA06, 0x2FA07, 0x2FA08, 0x2FA09, 0x2FA0A, 0x2FA0B, 0x2FA0C, 0x2FA0D,
+	0x2FA0E, 0x2FA0F, 0x2FA10, 0x2FA11, 0x2FA12, 0x2FA13, 0x2FA14, 0x2FA15,
+	0x2FA16, 0x2FA17, 0x2FA18, 0x2FA19, 0x2FA1A, 0x2FA1B, 0x2FA1C, 0x2FA1D,
+}
+
+// foldValues holds what each of foldKeys folds to
+var foldValues = [...]string{
+	"a", "b", "c", "d", "e", "f", "g", "h",
+	"i", "j", "k", "l", "m", "n", "o", "p",
+	"q", "r", "s", "t", "u", "v", "w", "x",
+	"y", "z", " ", " ", "a", " ", "2", "3",
+	" ", "μ", " ", "1", "o", "1⁄4", "1⁄2", "3⁄4",
+	"a", "a", "a", "a", "a", "a", "æ", "c",
+	"e", "e", "e", "e", "i", "i", "i", "i",
+	"ð", "n", "o", "o", "o", "o", "o", "ø",
+	"u", "u", "u", "u", "y", "þ", "ss", "a",
+	"a", "a", "a", "a", "a", "c", "e", "e",
+	"e", "e", "i", "i", "i", "i", "n", "o",
+	"o", "o", "o", "o", "u", "u", "u", "u",
+	"y", "y", "a", "a", "a", "a", "a", "a",
+	"c", "c", "c", "c", "c", "c", "c", "c",
+	"d", "d", "đ", "e", "e", "e", "e", "e",
+	"e", "e", "e", "e", "e", "g", "g", "g",
+	"g", "g", "g", "g", "g", "h", "h", "ħ",
+	"i", "i", "i", "i", "i", "i", "i", "i",
+	"i", "ij", "ij", "j", "j", "k", "k", "l",
+	"l", "l", "l", "l", "l", "l·", "l·", "ł",
+	"n", "n", "n", "n", "n", "n", "ʼn", "ŋ",
+	"o", "o", "o", "o", "o", "o", "œ", "r",
+	"r", "r", "r", "r", "r", "s", "s", "s",
+	"s", "s", "s", "s", "s", "t", "t", "t",
+	"t", "ŧ", "u", "u", "u", "u", "u", "u",
+	"u", "u", "u", "u", "u", "u", "w", "w",
+	"y", "y", "y", "z", "z", "z", "z", "z",
+	"z", "s", "ɓ", "ƃ", "ƅ", "ɔ", "ƈ", "ɖ",
+	"ɗ", "ƌ", "ǝ", "ə", "ɛ", "ƒ", "ɠ", "ɣ",
+	"ɩ", "ɨ", "ƙ", "ɯ", "ɲ", "ɵ", "o", "o",
+	"ƣ", "ƥ", "ʀ", "ƨ", "ʃ", "ƭ", "ʈ", "u",
+	"u", "ʊ", "ʋ", "ƴ", "ƶ", "ʒ", "ƹ", "ƽ",
+	"dz", "dz", "dz", "lj", "lj", "lj", "nj", "nj",
+	"nj", "a", "a", "i", "i", "o", "o", "u",
+	"u", "u", "u", "u", "u", "u", "u", "u",
+	"u", "a", "a", "a", "a", "æ", "æ", "ǥ",
+	"g", "g", "k", "k", "o", "o", "o", "o",
+	"ʒ", "ʒ", "j", "dz", "dz", "dz", "g", "g",
+	"ƕ", "ƿ", "n", "n", "a", "a", "æ", "æ",
+	"ø", "ø", "a", "a", "a", "a", "e", "e",
+	"e", "e", "i", "i", "i", "i", "o", "o",
+	"o", "o", "r", "r", "r", "r", "u", "u",
+	"u", "u", "s", "s", "t", "t", "ȝ", "h",
+	"h", "ƞ", "ȣ", "ȥ", "a", "a", "e", "e",
+	"o", "o", "o", "o", "o", "o", "o", "o",
+	"y", "y", "ⱥ", "ȼ", "ƚ", "ⱦ", "ɂ", "ƀ",
+	"ʉ", "ʌ", "ɇ", "ɉ", "ɋ", "ɍ", "ɏ", "h",
+	"ɦ", "j", "r", "ɹ", "ɻ", "ʁ", "w", "y",
+	" ", " ", " ", " ", " ", " ", "ɣ", "l",
+	"s", "x", "ʕ", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "ͱ", "ͳ", "ʹ", "ͷ", " ",
+	";", "ϳ", " ", " ", "α", "·", "ε", "η",
+	"ι", "ο", "υ", "ω", "ι", "α", "β", "γ",
+	"δ", "ε", "ζ", "η", "θ", "ι", "κ", "λ",
+	"μ", "ν", "ξ", "ο", "π", "ρ", "σ", "τ",
+	"υ", "φ", "χ", "ψ", "ω", "ι", "υ", "α",
+	"ε", "η", "ι", "υ", "σ", "ι", "υ", "ο",
+	"υ", "ω", "ϗ", "β", "θ", "υ", "υ", "υ",
+	"φ", "π", "ϙ", "ϛ", "ϝ", "ϟ", "ϡ", "ϣ",
+	"ϥ", "ϧ", "ϩ", "ϫ", "ϭ", "ϯ", "κ", "ρ",
+	"σ", "θ", "ε", "ϸ", "σ", "ϻ", "ͻ", "ͼ",
+	"ͽ", "е", "е", "ђ", "г", "є", "ѕ", "і",
+	"і", "ј", "љ", "њ", "ћ", "к", "и", "у",
+	"џ", "а", "б", "в", "г", "д", "е", "ж",
+	"з", "и", "и", "к", "л", "м", "н", "о",
+	"п", "р", "с", "т", "у", "ф", "х", "ц",
+	"ч", "ш", "щ", "ъ", "ы", "ь", "э", "ю",
+	"я", "и", "е", "е", "г", "і", "к", "и",
+	"у", "ѡ", "ѣ", "ѥ", "ѧ", "ѩ", "ѫ", "ѭ",
+	"ѯ", "ѱ", "ѳ", "ѵ", "ѵ", "ѵ", "ѹ", "ѻ",
+	"ѽ", "ѿ", "ҁ", "ҋ", "ҍ", "ҏ", "ґ", "ғ",
+	"ҕ", "җ", "ҙ", "қ", "ҝ", "ҟ", "ҡ", "ң",
+	"ҥ", "ҧ", "ҩ", "ҫ", "ҭ", "ү", "ұ", "ҳ",
+	"ҵ", "ҷ", "ҹ", "һ", "ҽ", "ҿ", "ӏ", "ж",
+	"ж", "ӄ", "ӆ", "ӈ", "ӊ", "ӌ", "ӎ", "а",
+	"а", "а", "а", "ӕ", "е", "е", "ә", "ә",
+	"ә", "ж", "ж", "з", "з", "ӡ", "и", "и",
+	"и", "и", "о", "о", "ө", "ө", "ө", "э",
+	"э", "у", "у", "у", "у", "у", "у", "ч",
+	"ч", "ӷ", "ы", "ы", "ӻ", "ӽ", "ӿ", "ԁ",
+	"ԃ", "ԅ", "ԇ", "ԉ", "ԋ", "ԍ", "ԏ", "ԑ",
+	"ԓ", "ԕ", "ԗ", "ԙ", "ԛ", "ԝ", "ԟ", "ԡ",
+	"ԣ", "ԥ", "ԧ", "ԩ", "ԫ", "ԭ", "ԯ", "ա",
+	"բ", "գ", "դ", "ե", "զ", "է", "ը", "թ",
+	"ժ", "ի", "լ", "խ", "ծ", "կ", "հ", "ձ",
+	"ղ", "ճ", "մ", "յ", "ն", "շ", "ո", "չ",
+	"պ", "ջ", "ռ", "ս", "վ", "տ", "ր", "ց",
+	"ւ", "փ", "ք", "օ", "ֆ", "եւ", "اٴ", "وٴ",
+	"ۇٴ", "يٴ", "ํา", "ໍາ", "ຫນ", "ຫມ", "་", "ྲཱྀ",
+	"ླཱྀ", "ⴀ", "ⴁ", "ⴂ", "ⴃ", "ⴄ", "ⴅ", "ⴆ",
+	"ⴇ", "ⴈ", "ⴉ", "ⴊ", "ⴋ", "ⴌ", "ⴍ", "ⴎ",
+	"ⴏ", "ⴐ", "ⴑ", "ⴒ", "ⴓ", "ⴔ", "ⴕ", "ⴖ",
+	"ⴗ", "ⴘ", "ⴙ", "ⴚ", "ⴛ", "ⴜ", "ⴝ", "ⴞ",
+	"ⴟ", "ⴠ", "ⴡ", "ⴢ", "ⴣ", "ⴤ", "ⴥ", "ⴧ",
+	"ⴭ", "ნ", "Ᏸ", "Ᏹ", "Ᏺ", "Ᏻ", "Ᏼ", "Ᏽ",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "в",
+	"д", "о", "с", "т", "т", "ъ", "ѣ", "ꙋ",
+	"ა", "ბ", "გ", "დ", "ე", "ვ", "ზ", "თ",
+	"ი", "კ", "ლ", "მ", "ნ", "ო", "პ", "ჟ",
+	"რ", "ს", "ტ", "უ", "ფ", "ქ", "ღ", "ყ",
+	"შ", "ჩ", "ც", "ძ", "წ", "ჭ", "ხ", "ჯ",
+	"ჰ", "ჱ", "ჲ", "ჳ", "ჴ", "ჵ", "ჶ", "ჷ",
+	"ჸ", "ჹ", "ჺ", "ჽ", "ჾ", "ჿ", "a", "æ",
+	"b", "d", "e", "ǝ", "g", "h", "i", "j",
+	"k", "l", "m", "n", "o", "ȣ", "p", "r",
+	"t", "u", "w", "a", "ɐ", "ɑ", "ᴂ", "b",
+	"d", "e", "ə", "ɛ", "ɜ", "g", "k", "m",
+	"ŋ", "o", "ɔ", "ᴖ", "ᴗ", "p", "t", "u",
+	"ᴝ", "ɯ", "v", "ᴥ", "β", "γ", "δ", "φ",
+	"χ", "i", "r", "u", "v", "β", "γ", "ρ",
+	"φ", "χ", "н", "ɒ", "c", "ɕ", "ð", "ɜ",
+	"f", "ɟ", "ɡ", "ɥ", "ɨ", "ɩ", "ɪ", "ᵻ",
+	"ʝ", "ɭ", "ᶅ", "ʟ", "ɱ", "ɰ", "ɲ", "ɳ",
+	"ɴ", "ɵ", "ɸ", "ʂ", "ʃ", "ƫ", "ʉ", "ʊ",
+	"ᴜ", "ʋ", "ʌ", "z", "ʐ", "ʑ", "ʒ", "θ",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"a", "a", "b", "b", "b", "b", "b", "b",
+	"c", "c", "d", "d", "d", "d", "d", "d",
+	"d", "d", "d", "d", "e", "e", "e", "e",
+	"e", "e", "e", "e", "e", "e", "f", "f",
+	"g", "g", "h", "h", "h", "h", "h", "h",
+	"h", "h", "h", "h", "i", "i", "i", "i",
+	"k", "k", "k", "k", "k", "k", "l", "l",
+	"l", "l", "l", "l", "l", "l", "m", "m",
+	"m", "m", "m", "m", "n", "n", "n", "n",
+	"n", "n", "n", "n", "o", "o", "o", "o",
+	"o", "o", "o", "o", "p", "p", "p", "p",
+	"r", "r", "r", "r", "r", "r", "r", "r",
+	"s", "s", "s", "s", "s", "s", "s", "s",
+	"s", "s", "t", "t", "t", "t", "t", "t",
+	"t", "t", "u", "u", "u", "u", "u", "u",
+	"u", "u", "u", "u", "v", "v", "v", "v",
+	"w", "w", "w", "w", "w", "w", "w", "w",
+	"w", "w", "x", "x", "x", "x", "y", "y",
+	"z", "z", "z", "z", "z", "z", "h", "t",
+	"w", "y", "aʾ", "s", "ss", "a", "a", "a",
+	"a", "a", "a", "a", "a", "a", "a", "a",
+	"a", "a", "a", "a", "a", "a", "a", "a",
+	"a", "a", "a", "a", "a", "e", "e", "e",
+	"e", "e", "e", "e", "e", "e", "e", "e",
+	"e", "e", "e", "e", "e", "i", "i", "i",
+	"i", "o", "o", "o", "o", "o", "o", "o",
+	"o", "o", "o", "o", "o", "o", "o", "o",
+	"o", "o", "o", "o", "o", "o", "o", "o",
+	"o", "u", "u", "u", "u", "u", "u", "u",
+	"u", "u", "u", "u", "u", "u", "u", "y",
+	"y", "y", "y", "y", "y", "y", "y", "ỻ",
+	"ỽ", "ỿ", "α", "α", "α", "α", "α", "α",
+	"α", "α", "α", "α", "α", "α", "α", "α",
+	"α", "α", "ε", "ε", "ε", "ε", "ε", "ε",
+	"ε", "ε", "ε", "ε", "ε", "ε", "η", "η",
+	"η", "η", "η", "η", "η", "η", "η", "η",
+	"η", "η", "η", "η", "η", "η", "ι", "ι",
+	"ι", "ι", "ι", "ι", "ι", "ι", "ι", "ι",
+	"ι", "ι", "ι", "ι", "ι", "ι", "ο", "ο",
+	"ο", "ο", "ο", "ο", "ο", "ο", "ο", "ο",
+	"ο", "ο", "υ", "υ", "υ", "υ", "υ", "υ",
+	"υ", "υ", "υ", "υ", "υ", "υ", "ω", "ω",
+	"ω", "ω", "ω", "ω", "ω", "ω", "ω", "ω",
+	"ω", "ω", "ω", "ω", "ω", "ω", "α", "α",
+	"ε", "ε", "η", "η", "ι", "ι", "ο", "ο",
+	"υ", "υ", "ω", "ω", "α", "α", "α", "α",
+	"α", "α", "α", "α", "α", "α", "α", "α",
+	"α", "α", "α", "α", "η", "η", "η", "η",
+	"η", "η", "η", "η", "η", "η", "η", "η",
+	"η", "η", "η", "η", "ω", "ω", "ω", "ω",
+	"ω", "ω", "ω", "ω", "ω", "ω", "ω", "ω",
+	"ω", "ω", "ω", "ω", "α", "α", "α", "α",
+	"α", "α", "α", "α", "α", "α", "α", "α",
+	" ", "ι", " ", " ", " ", "η", "η", "η",
+	"η", "η", "ε", "ε", "η", "η", "η", " ",
+	" ", " ", "ι", "ι", "ι", "ι", "ι", "ι",
+	"ι", "ι", "ι", "ι", " ", " ", " ", "υ",
+	"υ", "υ", "υ", "ρ", "ρ", "υ", "υ", "υ",
+	"υ", "υ", "υ", "ρ", " ", " ", "`", "ω",
+	"ω", "ω", "ω", "ω", "ο", "ο", "ω", "ω",
+	"ω", " ", " ", " ", " ", " ", " ", " ",
+	" ", " ", " ", " ", " ", " ", "‐", " ",
+	".", "..", "...", " ", "′′", "′′′", "‵‵", "‵‵‵",
+	"!!", " ", "??", "?!", "!?", "′′′′", " ", "0",
+	"i", "4", "5", "6", "7", "8", "9", "+",
+	"−", "=", "(", ")", "n", "0", "1", "2",
+	"3", "4", "5", "6", "7", "8", "9", "+",
+	"−", "=", "(", ")", "a", "e", "o", "x",
+	"ə", "h", "k", "l", "m", "n", "p", "s",
+	"t", "rs", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "a/c", "a/s", "c", "°c", "c/o",
+	"c/u", "ɛ", "°f", "g", "h", "h", "h", "h",
+	"ħ", "i", "i", "l", "l", "n", "no", "p",
+	"q", "r", "r", "r", "sm", "tel", "tm", "z",
+	"ω", "z", "k", "a", "b", "c", "e", "e",
+	"f", "ⅎ", "m", "o", "א", "ב", "ג", "ד",
+	"i", "fax", "π", "γ", "γ", "π", "∑", "d",
+	"d", "e", "i", "j", "1⁄7", "1⁄9", "1⁄10", "1⁄3",
+	"2⁄3", "1⁄5", "2⁄5", "3⁄5", "4⁄5", "1⁄6", "5⁄6", "1⁄8",
+	"3⁄8", "5⁄8", "7⁄8", "1⁄", "i", "ii", "iii", "iv",
+	"v", "vi", "vii", "viii", "ix", "x", "xi", "xii",
+	"l", "c", "d", "m", "i", "ii", "iii", "iv",
+	"v", "vi", "vii", "viii", "ix", "x", "xi", "xii",
+	"l", "c", "d", "m", "ↄ", "0⁄3", "←", "→",
+	"↔", "⇐", "⇔", "⇒", "∃", "∈", "∋", "∣",
+	"∥", "∫∫", "∫∫∫", "∮∮", "∮∮∮", "∼", "≃", "≅",
+	"≈", "=", "≡", "≍", "<", ">", "≤", "≥",
+	"≲", "≳", "≶", "≷", "≺", "≻", "⊂", "⊃",
+	"⊆", "⊇", "⊢", "⊨", "⊩", "⊫", "≼", "≽",
+	"⊑", "⊒", "⊲", "⊳", "⊴", "⊵", "〈", "〉",
+	"1", "2", "3", "4", "5", "6", "7", "8",
+	"9", "10", "11", "12", "13", "14", "15", "16",
+	"17", "18", "19", "20", "(1)", "(2)", "(3)", "(4)",
+	"(5)", "(6)", "(7)", "(8)", "(9)", "(10)", "(11)", "(12)",
+	"(13)", "(14)", "(15)", "(16)", "(17)", "(18)", "(19)", "(20)",
+	"1.", "2.", "3.", "4.", "5.", "6.", "7.", "8.",
+	"9.", "10.", "11.", "12.", "13.", "14.", "15.", "16.",
+	"17.", "18.", "19.", "20.", "(a)", "(b)", "(c)", "(d)",
+	"(e)", "(f)", "(g)", "(h)", "(i)", "(j)", "(k)", "(l)",
+	"(m)", "(n)", "(o)", "(p)", "(q)", "(r)", "(s)", "(t)",
+	"(u)", "(v)", "(w)", "(x)", "(y)", "(z)", "a", "b",
+	"c", "d", "e", "f", "g", "h", "i", "j",
+	"k", "l", "m", "n", "o", "p", "q", "r",
+	"s", "t", "u", "v", "w", "x", "y", "z",
+	"a", "b", "c", "d", "e", "f", "g", "h",
+	"i", "j", "k", "l", "m", "n", "o", "p",
+	"q", "r", "s", "t", "u", "v", "w", "x",
+	"y", "z", "0", "∫∫∫∫", "::=", "==", "===", "⫝",
+	"ⰰ", "ⰱ", "ⰲ", "ⰳ", "ⰴ", "ⰵ", "ⰶ", "ⰷ",
+	"ⰸ", "ⰹ", "ⰺ", "ⰻ", "ⰼ", "ⰽ", "ⰾ", "ⰿ",
+	"ⱀ", "ⱁ", "ⱂ", "ⱃ", "ⱄ", "ⱅ", "ⱆ", "ⱇ",
+	"ⱈ", "ⱉ", "ⱊ", "ⱋ", "ⱌ", "ⱍ", "ⱎ", "ⱏ",
+	"ⱐ", "ⱑ", "ⱒ", "ⱓ", "ⱔ", "ⱕ", "ⱖ", "ⱗ",
+	"ⱘ", "ⱙ", "ⱚ", "ⱛ", "ⱜ", "ⱝ", "ⱞ", "ⱟ",
+	"ⱡ", "ɫ", "ᵽ", "ɽ", "ⱨ", "ⱪ", "ⱬ", "ɑ",
+	"ɱ", "ɐ", "ɒ", "ⱳ", "ⱶ", "j", "v", "ȿ",
+	"ɀ", "ⲁ", "ⲃ", "ⲅ", "ⲇ", "ⲉ", "ⲋ", "ⲍ",
+	"ⲏ", "ⲑ", "ⲓ", "ⲕ", "ⲗ", "ⲙ", "ⲛ", "ⲝ",
+	"ⲟ", "ⲡ", "ⲣ", "ⲥ", "ⲧ", "ⲩ", "ⲫ", "ⲭ",
+	"ⲯ", "ⲱ", "ⲳ", "ⲵ", "ⲷ", "ⲹ", "ⲻ", "ⲽ",
+	"ⲿ", "ⳁ", "ⳃ", "ⳅ", "ⳇ", "ⳉ", "ⳋ", "ⳍ",
+	"ⳏ", "ⳑ", "ⳓ", "ⳕ", "ⳗ", "ⳙ", "ⳛ", "ⳝ",
+	"ⳟ", "ⳡ", "ⳣ", "ⳬ", "ⳮ", "ⳳ", "ⵡ", "母",
+	"龟", "一", "丨", "丶", "丿", "乙", "亅", "二",
+	"亠", "人", "儿", "入", "八", "冂", "冖", "冫",
+	"几", "凵", "刀", "力", "勹", "匕", "匚", "匸",
+	"十", "卜", "卩", "厂", "厶", "又", "口", "囗",
+	"土", "士", "夂", "夊", "夕", "大", "女", "子",
+	"宀", "寸", "小", "尢", "尸", "屮", "山", "巛",
+	"工", "己", "巾", "干", "幺", "广", "廴", "廾",
+	"弋", "弓", "彐", "彡", "彳", "心", "戈", "戶",
+	"手", "支", "攴", "文", "斗", "斤", "方", "无",
+	"日", "曰", "月", "木", "欠", "止", "歹", "殳",
+	"毋", "比", "毛", "氏", "气", "水", "火", "爪",
+	"父", "爻", "爿", "片", "牙", "牛", "犬", "玄",
+	"玉", "瓜", "瓦", "甘", "生", "用", "田", "疋",
+	"疒", "癶", "白", "皮", "皿", "目", "矛", "矢",
+	"石", "示", "禸", "禾", "穴", "立", "竹", "米",
+	"糸", "缶", "网", "羊", "羽", "老", "而", "耒",
+	"耳", "聿", "肉", "臣", "自", "至", "臼", "舌",
+	"舛", "舟", "艮", "色", "艸", "虍", "虫", "血",
+	"行", "衣", "襾", "見", "角", "言", "谷", "豆",
+	"豕", "豸", "貝", "赤", "走", "足", "身", "車",
+	"辛", "辰", "辵", "邑", "酉", "釆", "里", "金",
+	"長", "門", "阜", "隶", "隹", "雨", "靑", "非",
+	"面", "革", "韋", "韭", "音", "頁", "風", "飛",
+	"食", "首", "香", "馬", "骨", "高", "髟", "鬥",
+	"鬯", "鬲", "鬼", "魚", "鳥", "鹵", "鹿", "麥",
+	"麻", "黃", "黍", "黑", "黹", "黽", "鼎", "鼓",
+	"鼠", "鼻", "齊", "齒", "龍", "龜", "龠", " ",
+	"〒", "十", "卄", "卅", " ゙", " ゚", "より", "コト",
+	"ᄀ", "ᄁ", "ᆪ", "ᄂ", "ᆬ", "ᆭ", "ᄃ", "ᄄ",
+	"ᄅ", "ᆰ", "ᆱ", "ᆲ", "ᆳ", "ᆴ", "ᆵ", "ᄚ",
+	"ᄆ", "ᄇ", "ᄈ", "ᄡ", "ᄉ", "ᄊ", "ᄋ", "ᄌ",
+	"ᄍ", "ᄎ", "ᄏ", "ᄐ", "ᄑ", "ᄒ", "ᅡ", "ᅢ",
+	"ᅣ", "ᅤ", "ᅥ", "ᅦ", "ᅧ", "ᅨ", "ᅩ", "ᅪ",
+	"ᅫ", "ᅬ", "ᅭ", "ᅮ", "ᅯ", "ᅰ", "ᅱ", "ᅲ",
+	"ᅳ", "ᅴ", "ᅵ", "ᅠ", "ᄔ", "ᄕ", "ᇇ", "ᇈ",
+	"ᇌ", "ᇎ", "ᇓ", "ᇗ", "ᇙ", "ᄜ", "ᇝ", "ᇟ",
+	"ᄝ", "ᄞ", "ᄠ", "ᄢ", "ᄣ", "ᄧ", "ᄩ", "ᄫ",
+	"ᄬ", "ᄭ", "ᄮ", "ᄯ", "ᄲ", "ᄶ", "ᅀ", "ᅇ",
+	"ᅌ", "ᇱ", "ᇲ", "ᅗ", "ᅘ", "ᅙ", "ᆄ", "ᆅ",
+	"ᆈ", "ᆑ", "ᆒ", "ᆔ", "ᆞ", "ᆡ", "一", "二",
+	"三", "四", "上", "中", "下", "甲", "乙", "丙",
+	"丁", "天", "地", "人", "(ᄀ)", "(ᄂ)", "(ᄃ)", "(ᄅ)",
+	"(ᄆ)", "(ᄇ)", "(ᄉ)", "(ᄋ)", "(ᄌ)", "(ᄎ)", "(ᄏ)", "(ᄐ)",
+	"(ᄑ)", "(ᄒ)", "(가)", "(나)", "(다)", "(라)", "(마)", "(바)",
+	"(사)", "(아)", "(자)", "(차)", "(카)", "(타)", "(파)", "(하)",
+	"(주)", "(오전)", "(오후)", "(一)", "(二)", "(三)", "(四)", "(五)",
+	"(六)", "(七)", "(八)", "(九)", "(十)", "(月)", "(火)", "(水)",
+	"(木)", "(金)", "(土)", "(日)", "(株)", "(有)", "(社)", "(名)",
+	"(特)", "(財)", "(祝)", "(労)", "(代)", "(呼)", "(学)", "(監)",
+	"(企)", "(資)", "(協)", "(祭)", "(休)", "(自)", "(至)", "問",
+	"幼", "文", "箏", "pte", "21", "22", "23", "24",
+	"25", "26", "27", "28", "29", "30", "31", "32",
+	"33", "34", "35", "ᄀ", "ᄂ", "ᄃ", "ᄅ", "ᄆ",
+	"ᄇ", "ᄉ", "ᄋ", "ᄌ", "ᄎ", "ᄏ", "ᄐ", "ᄑ",
+	"ᄒ", "가", "나", "다", "라", "마", "바", "사",
+	"아", "자", "차", "카", "타", "파", "하", "참고",
+	"주의", "우", "一", "二", "三", "四", "五", "六",
+	"七", "八", "九", "十", "月", "火", "水", "木",
+	"金", "土", "日", "株", "有", "社", "名", "特",
+	"財", "祝", "労", "秘", "男", "女", "適", "優",
+	"印", "注", "項", "休", "写", "正", "上", "中",
+	"下", "左", "右", "医", "宗", "学", "監", "企",
+	"資", "協", "夜", "36", "37", "38", "39", "40",
+	"41", "42", "43", "44", "45", "46", "47", "48",
+	"49", "50", "1月", "2月", "3月", "4月", "5月", "6月",
+	"7月", "8月", "9月", "10月", "11月", "12月", "hg", "erg",
+	"ev", "ltd", "ア", "イ", "ウ", "エ", "オ", "カ",
+	"キ", "ク", "ケ", "コ", "サ", "シ", "ス", "セ",
+	"ソ", "タ", "チ", "ツ", "テ", "ト", "ナ", "ニ",
+	"ヌ", "ネ", "ノ", "ハ", "ヒ", "フ", "ヘ", "ホ",
+	"マ", "ミ", "ム", "メ", "モ", "ヤ", "ユ", "ヨ",
+	"ラ", "リ", "ル", "レ", "ロ", "ワ", "ヰ", "ヱ",
+	"ヲ", "令和", "アパート", "アルファ", "アンペア", "アール", "イニング", "インチ",
+	"ウォン", "エスクード", "エーカー", "オンス", "オーム", "カイリ", "カラット", "カロリー",
+	"ガロン", "ガンマ", "ギガ", "ギニー", "キュリー", "ギルダー", "キロ", "キログラム",
+	"キロメートル", "キロワット", "グラム", "グラムトン", "クルゼイロ", "クローネ", "ケース", "コルナ",
+	"コーポ", "サイクル", "サンチーム", "シリング", "センチ", "セント", "ダース", "デシ",
+	"ドル", "トン", "ナノ", "ノット", "ハイツ", "パーセント", "パーツ", "バーレル",
+	"ピアストル", "ピクル", "ピコ", "ビル", "ファラッド", "フィート", "ブッシェル", "フラン",
+	"ヘクタール", "ペソ", "ペニヒ", "ヘルツ", "ペンス", "ページ", "ベータ", "ポイント",
+	"ボルト", "ホン", "ポンド", "ホール", "ホーン", "マイクロ", "マイル", "マッハ",
+	"マルク", "マンション", "ミクロン", "ミリ", "ミリバール", "メガ", "メガトン", "メートル",
+	"ヤード", "ヤール", "ユアン", "リットル", "リラ", "ルピー", "ルーブル", "レム",
+	"レントゲン", "ワット", "0点", "1点", "2点", "3点", "4点", "5点",
+	"6点", "7点", "8点", "9点", "10点", "11点", "12点", "13点",
+	"14点", "15点", "16点", "17点", "18点", "19点", "20点", "21点",
+	"22点", "23点", "24点", "hpa", "da", "au", "bar", "ov",
+	"pc", "dm", "dm2", "dm3", "iu", "平成", "昭和", "大正",
+	"明治", "株式会社", "pa", "na", "μa", "ma", "ka", "kb",
+	"mb", "gb", "cal", "kcal", "pf", "nf", "μf", "μg",
+	"mg", "kg", "hz", "khz", "mhz", "ghz", "thz", "μl",
+	"ml", "dl", "kl", "fm", "nm", "μm", "mm", "cm",
+	"km", "mm2", "cm2", "m2", "km2", "mm3", "cm3", "m3",
+	"km3", "m∕s", "m∕s2", "pa", "kpa", "mpa", "gpa", "rad",
+	"rad∕s", "rad∕s2", "ps", "ns", "μs", "ms", "pv", "nv",
+	"μv", "mv", "kv", "mv", "pw", "nw", "μw", "mw",
+	"kw", "mw", "kω", "mω", "a.m.", "bq", "cc", "cd",
+	"c∕kg", "co.", "db", "gy", "ha", "hp", "in", "kk",
+	"km", "kt", "lm", "ln", "log", "lx", "mb", "mil",
+	"mol", "ph", "p.m.", "ppm", "pr", "sr", "sv", "wb",
+	"v∕m", "a∕m", "1日", "2日", "3日", "4日", "5日", "6日",
+	"7日", "8日", "9日", "10日", "11日", "12日", "13日", "14日",
+	"15日", "16日", "17日", "18日", "19日", "20日", "21日", "22日",
+	"23日", "24日", "25日", "26日", "27日", "28日", "29日", "30日",
+	"31日", "gal", "ꙁ", "ꙃ", "ꙅ", "ꙇ", "ꙉ", "ꙋ",
+	"ꙍ", "ꙏ", "ꙑ", "ꙓ", "ꙕ", "ꙗ", "ꙙ", "ꙛ",
+	"ꙝ", "ꙟ", "ꙡ", "ꙣ", "ꙥ", "ꙧ", "ꙩ", "ꙫ",
+	"ꙭ", "ꚁ", "ꚃ", "ꚅ", "ꚇ", "ꚉ", "ꚋ", "ꚍ",
+	"ꚏ", "ꚑ", "ꚓ", "ꚕ", "ꚗ", "ꚙ", "ꚛ", "ъ",
+	"ь", "ꜣ", "ꜥ", "ꜧ", "ꜩ", "ꜫ", "ꜭ", "ꜯ",
+	"ꜳ", "ꜵ", "ꜷ", "ꜹ", "ꜻ", "ꜽ", "ꜿ", "ꝁ",
+	"ꝃ", "ꝅ", "ꝇ", "ꝉ", "ꝋ", "ꝍ", "ꝏ", "ꝑ",
+	"ꝓ", "ꝕ", "ꝗ", "ꝙ", "ꝛ", "ꝝ", "ꝟ", "ꝡ",
+	"ꝣ", "ꝥ", "ꝧ", "ꝩ", "ꝫ", "ꝭ", "ꝯ", "ꝯ",
+	"ꝺ", "ꝼ", "ᵹ", "ꝿ", "ꞁ", "ꞃ", "ꞅ", "ꞇ",
+	"ꞌ", "ɥ", "ꞑ", "ꞓ", "ꞗ", "ꞙ", "ꞛ", "ꞝ",
+	"ꞟ", "ꞡ", "ꞣ", "ꞥ", "ꞧ", "ꞩ", "ɦ", "ɜ",
+	"ɡ", "ɬ", "ɪ", "ʞ", "ʇ", "ʝ", "ꭓ", "ꞵ",
+	"ꞷ", "ꞹ", "ꞻ", "ꞽ", "ꞿ", "ꟁ", "ꟃ", "ꞔ",
+	"ʂ", "ᶎ", "ꟈ", "ꟊ", "ꟑ", "ꟗ", "ꟙ", "c",
+	"f", "q", "ꟶ", "ħ", "œ", "ꜧ", "ꬷ", "ɫ",
+	"ꭒ", "ʍ", "Ꭰ", "Ꭱ", "Ꭲ", "Ꭳ", "Ꭴ", "Ꭵ",
+	"Ꭶ", "Ꭷ", "Ꭸ", "Ꭹ", "Ꭺ", "Ꭻ", "Ꭼ", "Ꭽ",
+	"Ꭾ", "Ꭿ", "Ꮀ", "Ꮁ", "Ꮂ", "Ꮃ", "Ꮄ", "Ꮅ",
+	"Ꮆ", "Ꮇ", "Ꮈ", "Ꮉ", "Ꮊ", "Ꮋ", "Ꮌ", "Ꮍ",
+	"Ꮎ", "Ꮏ", "Ꮐ", "Ꮑ", "Ꮒ", "Ꮓ", "Ꮔ", "Ꮕ",
+	"Ꮖ", "Ꮗ", "Ꮘ", "Ꮙ", "Ꮚ", "Ꮛ", "Ꮜ", "Ꮝ",
+	"Ꮞ", "Ꮟ", "Ꮠ", "Ꮡ", "Ꮢ", "Ꮣ", "Ꮤ", "Ꮥ",
+	"Ꮦ", "Ꮧ", "Ꮨ", "Ꮩ", "Ꮪ", "Ꮫ", "Ꮬ", "Ꮭ",
+	"Ꮮ", "Ꮯ", "Ꮰ", "Ꮱ", "Ꮲ", "Ꮳ", "Ꮴ", "Ꮵ",
+	"Ꮶ", "Ꮷ", "Ꮸ", "Ꮹ", "Ꮺ", "Ꮻ", "Ꮼ", "Ꮽ",
+	"Ꮾ", "Ꮿ", "豈", "更", "車", "賈", "滑", "串",
+	"句", "龜", "龜", "契", "金", "喇", "奈", "懶",
+	"癩", "羅", "蘿", "螺", "裸", "邏", "樂", "洛",
+	"烙", "珞", "落", "酪", "駱", "亂", "卵", "欄",
+	"爛", "蘭", "鸞", "嵐", "濫", "藍", "襤", "拉",
+	"臘", "蠟", "廊", "朗", "浪", "狼", "郎", "來",
+	"冷", "勞", "擄", "櫓", "爐", "盧", "老", "蘆",
+	"虜", "路", "露", "魯", "鷺", "碌", "祿", "綠",
+	"菉", "錄", "鹿", "論", "壟", "弄", "籠", "聾",
+	"牢", "磊", "賂", "雷", "壘", "屢", "樓", "淚",
+	"漏", "累", "縷", "陋", "勒", "肋", "凜", "凌",
+	"稜", "綾", "菱", "陵", "讀", "拏", "樂", "諾",
+	"丹", "寧", "怒", "率", "異", "北", "磻", "便",
+	"復", "不", "泌", "數", "索", "參", "塞", "省",
+	"葉", "說", "殺", "辰", "沈", "拾", "若", "掠",
+	"略", "亮", "兩", "凉", "梁", "糧", "良", "諒",
+	"量", "勵", "呂", "女", "廬", "旅", "濾", "礪",
+	"閭", "驪", "麗", "黎", "力", "曆", "歷", "轢",
+	"年", "憐", "戀", "撚", "漣", "煉", "璉", "秊",
+	"練", "聯", "輦", "蓮", "連", "鍊", "列", "劣",
+	"咽", "烈", "裂", "說", "廉", "念", "捻", "殮",
+	"簾", "獵", "令", "囹", "寧", "嶺", "怜", "玲",
+	"瑩", "羚", "聆", "鈴", "零", "靈", "領", "例",
+	"禮", "醴", "隸", "惡", "了", "僚", "寮", "尿",
+	"料", "樂", "燎", "療", "蓼", "遼", "龍", "暈",
+	"阮", "劉", "杻", "柳", "流", "溜", "琉", "留",
+	"硫", "紐", "類", "六", "戮", "陸", "倫", "崙",
+	"淪", "輪", "律", "慄", "栗", "率", "隆", "利",
+	"吏", "履", "易", "李", "梨", "泥", "理", "痢",
+	"罹", "裏", "裡", "里", "離", "匿", "溺", "吝",
+	"燐", "璘", "藺", "隣", "鱗", "麟", "林", "淋",
+	"臨", "立", "笠", "粒", "狀", "炙", "識", "什",
+	"茶", "刺", "切", "度", "拓", "糖", "宅", "洞",
+	"暴", "輻", "行", "降", "見", "廓", "兀", "嗀",
+	"塚", "晴", "凞", "猪", "益", "礼", "神", "祥",
+	"福", "靖", "精", "羽", "蘒", "諸", "逸", "都",
+	"飯", "飼", "館", "鶴", "郞", "隷", "侮", "僧",
+	"免", "勉", "勤", "卑", "喝", "嘆", "器", "塀",
+	"墨", "層", "屮", "悔", "慨", "憎", "懲", "敏",
+	"既", "暑", "梅", "海", "渚", "漢", "煮", "爫",
+	"琢", "碑", "社", "祉", "祈", "祐", "祖", "祝",
+	"禍", "禎", "穀", "突", "節", "練", "縉", "繁",
+	"署", "者", "臭", "艹", "艹", "著", "褐", "視",
+	"謁", "謹", "賓", "贈", "辶", "逸", "難", "響",
+	"頻", "恵", "𤋮", "舘", "並", "况", "全", "侀",
+	"充", "冀", "勇", "勺", "喝", "啕", "喙", "嗢",
+	"塚", "墳", "奄", "奔", "婢", "嬨", "廒", "廙",
+	"彩", "徭", "惘", "慎", "愈", "憎", "慠", "懲",
+	"戴", "揄", "搜", "摒", "敖", "晴", "朗", "望",
+	"杖", "歹", "殺", "流", "滛", "滋", "漢", "瀞",
+	"煮", "瞧", "爵", "犯", "猪", "瑱", "甆", "画",
+	"瘝", "瘟", "益", "盛", "直", "睊", "着", "磌",
+	"窱", "節", "类", "絛", "練", "缾", "者", "荒",
+	"華", "蝹", "襁", "覆", "視", "調", "諸", "請",
+	"謁", "諾", "諭", "謹", "變", "贈", "輸", "遲",
+	"醙", "鉶", "陼", "難", "靖", "韛", "響", "頋",
+	"頻", "鬒", "龜", "𢡊", "𢡄", "𣏕", "㮝", "䀘",
+	"䀹", "𥉉", "𥳐", "𧻓", "齃", "龎", "ff", "fi",
+	"fl", "ffi", "ffl", "st", "st", "մն", "մե", "մի",
+	"վն", "մխ", "ע", "א", "ד", "ה", "כ", "ל",
+	"ם", "ר", "ת", "+", "אל", "ٱ", "ٱ", "ٻ",
+	"ٻ", "ٻ", "ٻ", "پ", "پ", "پ", "پ", "ڀ",
+	"ڀ", "ڀ", "ڀ", "ٺ", "ٺ", "ٺ", "ٺ", "ٿ",
+	"ٿ", "ٿ", "ٿ", "ٹ", "ٹ", "ٹ", "ٹ", "ڤ",
+	"ڤ", "ڤ", "ڤ", "ڦ", "ڦ", "ڦ", "ڦ", "ڄ",
+	"ڄ", "ڄ", "ڄ", "ڃ", "ڃ", "ڃ", "ڃ", "چ",
+	"چ", "چ", "چ", "ڇ", "ڇ", "ڇ", "ڇ", "ڍ",
+	"ڍ", "ڌ", "ڌ", "ڎ", "ڎ", "ڈ", "ڈ", "ژ",
+	"ژ", "ڑ", "ڑ", "ک", "ک", "ک", "ک", "گ",
+	"گ", "گ", "گ", "ڳ", "ڳ", "ڳ", "ڳ", "ڱ",
+	"ڱ", "ڱ", "ڱ", "ں", "ں", "ڻ", "ڻ", "ڻ",
+	"ڻ", "ۀ", "ۀ", "ہ", "ہ", "ہ", "ہ", "ھ",
+	"ھ", "ھ", "ھ", "ے", "ے", "ۓ", "ۓ", "ڭ",
+	"ڭ", "ڭ", "ڭ", "ۇ", "ۇ", "ۆ", "ۆ", "ۈ",
+	"ۈ", "ۇٴ", "ۋ", "ۋ", "ۅ", "ۅ", "ۉ", "ۉ",
+	"ې", "ې", "ې", "ې", "ى", "ى", "ئا", "ئا",
+	"ئە", "ئە", "ئو", "ئو", "ئۇ", "ئۇ", "ئۆ", "ئۆ",
+	"ئۈ", "ئۈ", "ئې", "ئې", "ئې", "ئى", "ئى", "ئى",
+	"ی", "ی", "ی", "ی", "ئج", "ئح", "ئم", "ئى",
+	"ئي", "بج", "بح", "بخ", "بم", "بى", "بي", "تج",
+	"تح", "تخ", "تم", "تى", "تي", "ثج", "ثم", "ثى",
+	"ثي", "جح", "جم", "حج", "حم", "خج", "خح", "خم",
+	"سج", "سح", "سخ", "سم", "صح", "صم", "ضج", "ضح",
+	"ضخ", "ضم", "طح", "طم", "ظم", "عج", "عم", "غج",
+	"غم", "فج", "فح", "فخ", "فم", "فى", "في", "قح",
+	"قم", "قى", "قي", "كا", "كج", "كح", "كخ", "كل",
+	"كم", "كى", "كي", "لج", "لح", "لخ", "لم", "لى",
+	"لي", "مج", "مح", "مخ", "مم", "مى", "مي", "نج",
+	"نح", "نخ", "نم", "نى", "ني", "هج", "هم", "هى",
+	"هي", "يج", "يح", "يخ", "يم", "يى", "يي", "ذٰ",
+	"رٰ", "ىٰ", " ٌّ", " ٍّ", " َّ", " ُّ", " ِّ", " ّٰ",
+	"ئر", "ئز", "ئم", "ئن", "ئى", "ئي", "بر", "بز",
+	"بم", "بن", "بى", "بي", "تر", "تز", "تم", "تن",
+	"تى", "تي", "ثر", "ثز", "ثم", "ثن", "ثى", "ثي",
+	"فى", "في", "قى", "قي", "كا", "كل", "كم", "كى",
+	"كي", "لم", "لى", "لي", "ما", "مم", "نر", "نز",
+	"نم", "نن", "نى", "ني", "ىٰ", "ير", "يز", "يم",
+	"ين", "يى", "يي", "ئج", "ئح", "ئخ", "ئم", "ئه",
+	"بج", "بح", "بخ", "بم", "به", "تج", "تح", "تخ",
+	"تم", "ته", "ثم", "جح", "جم", "حج", "حم", "خج",
+	"خم", "سج", "سح", "سخ", "سم", "صح", "صخ", "صم",
+	"ضج", "ضح", "ضخ", "ضم", "طح", "ظم", "عج", "عم",
+	"غج", "غم", "فج", "فح", "فخ", "فم", "قح", "قم",
+	"كج", "كح", "كخ", "كل", "كم", "لج", "لح", "لخ",
+	"لم", "له", "مج", "مح", "مخ", "مم", "نج", "نح",
+	"نخ", "نم", "نه", "هج", "هم", "هٰ", "يج", "يح",
+	"يخ", "يم", "يه", "ئم", "ئه", "بم", "به", "تم",
+	"ته", "ثم", "ثه", "سم", "سه", "شم", "شه", "كل",
+	"كم", "لم", "نم", "نه", "يم", "يه", "ـَّ", "ـُّ",
+	"ـِّ", "طى", "طي", "عى", "عي", "غى", "غي", "سى",
+	"سي", "شى", "شي", "حى", "حي", "جى", "جي", "خى",
+	"خي", "صى", "صي", "ضى", "ضي", "شج", "شح", "شخ",
+	"شم", "شر", "سر", "صر", "ضر", "طى", "طي", "عى",
+	"عي", "غى", "غي", "سى", "سي", "شى", "شي", "حى",
+	"حي", "جى", "جي", "خى", "خي", "صى", "صي", "ضى",
+	"ضي", "شج", "شح", "شخ", "شم", "شر", "سر", "صر",
+	"ضر", "شج", "شح", "شخ", "شم", "سه", "شه", "طم",
+	"سج", "سح", "سخ", "شج", "شح", "شخ", "طم", "ظم",
+	"اً", "اً", "تجم", "تحج", "تحج", "تحم", "تخم", "تمج",
+	"تمح", "تمخ", "جمح", "جمح", "حمي", "حمى", "سحج", "سجح",
+	"سجى", "سمح", "سمح", "سمج", "سمم", "سمم", "صحح", "صحح",
+	"صمم", "شحم", "شحم", "شجي", "شمخ", "شمخ", "شمم", "شمم",
+	"ضحى", "ضخم", "ضخم", "طمح", "طمح", "طمم", "طمي", "عجم",
+	"عمم", "عمم", "عمى", "غمم", "غمي", "غمى", "فخم", "فخم",
+	"قمح", "قمم", "لحم", "لحي", "لحى", "لجج", "لجج", "لخم",
+	"لخم", "لمح", "لمح", "محج", "محم", "محي", "مجح", "مجم",
+	"مخج", "مخم", "مجخ", "همج", "همم", "نحم", "نحى", "نجم",
+	"نجم", "نجى", "نمي", "نمى", "يمم", "يمم", "بخي", "تجي",
+	"تجى", "تخي", "تخى", "تمي", "تمى", "جمي", "جحى", "جمى",
+	"سخى", "صحي", "شحي", "ضحي", "لجي", "لمي", "يحي", "يجي",
+	"يمي", "ممي", "قمي", "نحي", "قمح", "لحم", "عمي", "كمي",
+	"نجح", "مخي", "لجم", "كمم", "لجم", "نجح", "جحي", "حجي",
+	"مجي", "فمي", "بحي", "كمم", "عجم", "صمم", "سخي", "نجي",
+	"صلے", "قلے", "الله", "اكبر", "محمد", "صلعم", "رسول", "عليه",
+	"وسلم", "صلى", "صلى الله عليه وسلم", "جل جلاله", "ریال", ",", "、", "。",
+	":", ";", "!", "?", "〖", "〗", "...", "",
+	"", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "..",
+	"—", "–", "_", "_", "(", ")", "{", "}",
+	"〔", "〕", "【", "】", "《", "》", "〈", "〉",
+	"「", "」", "『", "』", "[", "]", " ", " ",
+	" ", " ", "_", "_", "_", ",", "、", ".",
+	";", ":", "?", "!", "—", "(", ")", "{",
+	"}", "〔", "〕", "#", "&", "*", "+", "-",
+	"<", ">", "=", "\\", "$", "%", "@", " ً",
+	"ـً", " ٌ", " ٍ", " َ", "ـَ", " ُ", "ـُ", " ِ",
+	"ـِ", " ّ", "ـّ", " ْ", "ـْ", "ء", "آ", "آ",
+	"أ", "أ", "ؤ", "ؤ", "إ", "إ", "ئ", "ئ",
+	"ئ", "ئ", "ا", "ا", "ب", "ب", "ب", "ب",
+	"ة", "ة", "ت", "ت", "ت", "ت", "ث", "ث",
+	"ث", "ث", "ج", "ج", "ج", "ج", "ح", "ح",
+	"ح", "ح", "خ", "خ", "خ", "خ", "د", "د",
+	"ذ", "ذ", "ر", "ر", "ز", "ز", "س", "س",
+	"س", "س", "ش", "ش", "ش", "ش", "ص", "ص",
+	"ص", "ص", "ض", "ض", "ض", "ض", "ط", "ط",
+	"ط", "ط", "ظ", "ظ", "ظ", "ظ", "ع", "ع",
+	"ع", "ع", "غ", "غ", "غ", "غ", "ف", "ف",
+	"ف", "ف", "ق", "ق", "ق", "ق", "ك", "ك",
+	"ك", "ك", "ل", "ل", "ل", "ل", "م", "م",
+	"م", "م", "ن", "ن", "ن", "ن", "ه", "ه",
+	"ه", "ه", "و", "و", "ى", "ى", "ي", "ي",
+	"ي", "ي", "لآ", "لآ", "لأ", "لأ", "لإ", "لإ",
+	"لا", "لا", "!", "\"", "#", "$", "%", "&",
+	"'", "(", ")", "*", "+", ",", "-", ".",
+	"/", "0", "1", "2", "3", "4", "5", "6",
+	"7", "8", "9", ":", ";", "<", "=", ">",
+	"?", "@", "a", "b", "c", "d", "e", "f",
+	"g", "h", "i", "j", "k", "l", "m", "n",
+	"o", "p", "q", "r", "s", "t", "u", "v",
+	"w", "x", "y", "z", "[", "\\", "]", "^",
+	"_", "`", "a", "b", "c", "d", "e", "f",
+	"g", "h", "i", "j", "k", "l", "m", "n",
+	"o", "p", "q", "r", "s", "t", "u", "v",
+	"w", "x", "y", "z", "{", "|", "}", "~",
+	"⦅", "⦆", "。", "「", "」", "、", "・", "ヲ",
+	"ァ", "ィ", "ゥ", "ェ", "ォ", "ャ", "ュ", "ョ",
+	"ッ", "ー", "ア", "イ", "ウ", "エ", "オ", "カ",
+	"キ", "ク", "ケ", "コ", "サ", "シ", "ス", "セ",
+	"ソ", "タ", "チ", "ツ", "テ", "ト", "ナ", "ニ",
+	"ヌ", "ネ", "ノ", "ハ", "ヒ", "フ", "ヘ", "ホ",
+	"マ", "ミ", "ム", "メ", "モ", "ヤ", "ユ", "ヨ",
+	"ラ", "リ", "ル", "レ", "ロ", "ワ", "ン", "゙",
+	"゚", "ᅠ", "ᄀ", "ᄁ", "ᆪ", "ᄂ", "ᆬ", "ᆭ",
+	"ᄃ", "ᄄ", "ᄅ", "ᆰ", "ᆱ", "ᆲ", "ᆳ", "ᆴ",
+	"ᆵ", "ᄚ", "ᄆ", "ᄇ", "ᄈ", "ᄡ", "ᄉ", "ᄊ",
+	"ᄋ", "ᄌ", "ᄍ", "ᄎ", "ᄏ", "ᄐ", "ᄑ", "ᄒ",
+	"ᅡ", "ᅢ", "ᅣ", "ᅤ", "ᅥ", "ᅦ", "ᅧ", "ᅨ",
+	"ᅩ", "ᅪ", "ᅫ", "ᅬ", "ᅭ", "ᅮ", "ᅯ", "ᅰ",
+	"ᅱ", "ᅲ", "ᅳ", "ᅴ", "ᅵ", "¢", "£", "¬",
+	" ", "¦", "¥", "₩", "│", "←", "↑", "→",
+	"↓", "■", "○", "𐐨", "𐐩", "𐐪", "𐐫", "𐐬",
+	"𐐭", "𐐮", "𐐯", "𐐰", "𐐱", "𐐲", "𐐳", "𐐴",
+	"𐐵", "𐐶", "𐐷", "𐐸", "𐐹", "𐐺", "𐐻", "𐐼",
+	"𐐽", "𐐾", "𐐿", "𐑀", "𐑁", "𐑂", "𐑃", "𐑄",
+	"𐑅", "𐑆", "𐑇", "𐑈", "𐑉", "𐑊", "𐑋", "𐑌",
+	"𐑍", "𐑎", "𐑏", "𐓘", "𐓙", "𐓚", "𐓛", "𐓜",
+	"𐓝", "𐓞", "𐓟", "𐓠", "𐓡", "𐓢", "𐓣", "𐓤",
+	"𐓥", "𐓦", "𐓧", "𐓨", "𐓩", "𐓪", "𐓫", "𐓬",
+	"𐓭", "𐓮", "𐓯", "𐓰", "𐓱", "𐓲", "𐓳", "𐓴",
+	"𐓵", "𐓶", "𐓷", "𐓸", "𐓹", "𐓺", "𐓻", "𐖗",
+	"𐖘", "𐖙", "𐖚", "𐖛", "𐖜", "𐖝", "𐖞", "𐖟",
+	"𐖠", "𐖡", "𐖣", "𐖤", "𐖥", "𐖦", "𐖧", "𐖨",
+	"𐖩", "𐖪", "𐖫", "𐖬", "𐖭", "𐖮", "𐖯", "𐖰",
+	"𐖱", "𐖳", "𐖴", "𐖵", "𐖶", "𐖷", "𐖸", "𐖹",
+	"𐖻", "𐖼", "ː", "ˑ", "æ", "ʙ", "ɓ", "ʣ",
+	"ꭦ", "ʥ", "ʤ", "ɖ", "ɗ", "ᶑ", "ɘ", "ɞ",
+	"ʩ", "ɤ", "ɢ", "ɠ", "ʛ", "ħ", "ʜ", "ɧ",
+	"ʄ", "ʪ", "ʫ", "ɬ", "𝼄", "ꞎ", "ɮ", "𝼅",
+	"ʎ", "𝼆", "ø", "ɶ", "ɷ", "q", "ɺ", "𝼈",
+	"ɽ", "ɾ", "ʀ", "ʨ", "ʦ", "ꭧ", "ʧ", "ʈ",
+	"ⱱ", "ʏ", "ʡ", "ʢ", "ʘ", "ǀ", "ǁ", "ǂ",
+	"𝼊", "𝼞", "𐳀", "𐳁", "𐳂", "𐳃", "𐳄", "𐳅",
+	"𐳆", "𐳇", "𐳈", "𐳉", "𐳊", "𐳋", "𐳌", "𐳍",
+	"𐳎", "𐳏", "𐳐", "𐳑", "𐳒", "𐳓", "𐳔", "𐳕",
+	"𐳖", "𐳗", "𐳘", "𐳙", "𐳚", "𐳛", "𐳜", "𐳝",
+	"𐳞", "𐳟", "𐳠", "𐳡", "𐳢", "𐳣", "𐳤", "𐳥",
+	"𐳦", "𐳧", "𐳨", "𐳩", "𐳪", "𐳫", "𐳬", "𐳭",
+	"𐳮", "𐳯", "𐳰", "𐳱", "𐳲", "𑣀", "𑣁", "𑣂",
+	"𑣃", "𑣄", "𑣅", "𑣆", "𑣇", "𑣈", "𑣉", "𑣊",
+	"𑣋", "𑣌", "𑣍", "𑣎", "𑣏", "𑣐", "𑣑", "𑣒",
+	"𑣓", "𑣔", "𑣕", "𑣖", "𑣗", "𑣘", "𑣙", "𑣚",
+	"𑣛", "𑣜", "𑣝", "𑣞", "𑣟", "𖹠", "𖹡", "𖹢",
+	"𖹣", "𖹤", "𖹥", "𖹦", "𖹧", "𖹨", "𖹩", "𖹪",
+	"𖹫", "𖹬", "𖹭", "𖹮", "𖹯", "𖹰", "𖹱", "𖹲",
+	"𖹳", "𖹴", "𖹵", "𖹶", "𖹷", "𖹸", "𖹹", "𖹺",
+	"𖹻", "𖹼", "𖹽", "𖹾", "𖹿", "a", "b", "c",
+	"d", "e", "f", "g", "h", "i", "j", "k",
+	"l", "m", "n", "o", "p", "q", "r", "s",
+	"t", "u", "v", "w", "x", "y", "z", "a",
+	"b", "c", "d", "e", "f", "g", "h", "i",
+	"j", "k", "l", "m", "n", "o", "p", "q",
+	"r", "s", "t", "u", "v", "w", "x", "y",
+	"z", "a", "b", "c", "d", "e", "f", "g",
+	"h", "i", "j", "k", "l", "m", "n", "o",
+	"p", "q", "r", "s", "t", "u", "v", "w",
+	"x", "y", "z", "a", "b", "c", "d", "e",
+	"f", "g", "i", "j", "k", "l", "m", "n",
+	"o", "p", "q", "r", "s", "t", "u", "v",
+	"w", "x", "y", "z", "a", "b", "c", "d",
+	"e", "f", "g", "h", "i", "j", "k", "l",
+	"m", "n", "o", "p", "q", "r", "s", "t",
+	"u", "v", "w", "x", "y", "z", "a", "b",
+	"c", "d", "e", "f", "g", "h", "i", "j",
+	"k", "l", "m", "n", "o", "p", "q", "r",
+	"s", "t", "u", "v", "w", "x", "y", "z",
+	"a", "c", "d", "g", "j", "k", "n", "o",
+	"p", "q", "s", "t", "u", "v", "w", "x",
+	"y", "z", "a", "b", "c", "d", "f", "h",
+	"i", "j", "k", "l", "m", "n", "p", "q",
+	"r", "s", "t", "u", "v", "w", "x", "y",
+	"z", "a", "b", "c", "d", "e", "f", "g",
+	"h", "i", "j", "k", "l", "m", "n", "o",
+	"p", "q", "r", "s", "t", "u", "v", "w",
+	"x", "y", "z", "a", "b", "c", "d", "e",
+	"f", "g", "h", "i", "j", "k", "l", "m",
+	"n", "o", "p", "q", "r", "s", "t", "u",
+	"v", "w", "x", "y", "z", "a", "b", "d",
+	"e", "f", "g", "j", "k", "l", "m", "n",
+	"o", "p", "q", "s", "t", "u", "v", "w",
+	"x", "y", "a", "b", "c", "d", "e", "f",
+	"g", "h", "i", "j", "k", "l", "m", "n",
+	"o", "p", "q", "r", "s", "t", "u", "v",
+	"w", "x", "y", "z", "a", "b", "d", "e",
+	"f", "g", "i", "j", "k", "l", "m", "o",
+	"s", "t", "u", "v", "w", "x", "y", "a",
+	"b", "c", "d", "e", "f", "g", "h", "i",
+	"j", "k", "l", "m", "n", "o", "p", "q",
+	"r", "s", "t", "u", "v", "w", "x", "y",
+	"z", "a", "b", "c", "d", "e", "f", "g",
+	"h", "i", "j", "k", "l", "m", "n", "o",
+	"p", "q", "r", "s", "t", "u", "v", "w",
+	"x", "y", "z", "a", "b", "c", "d", "e",
+	"f", "g", "h", "i", "j", "k", "l", "m",
+	"n", "o", "p", "q", "r", "s", "t", "u",
+	"v", "w", "x", "y", "z", "a", "b", "c",
+	"d", "e", "f", "g", "h", "i", "j", "k",
+	"l", "m", "n", "o", "p", "q", "r", "s",
+	"t", "u", "v", "w", "x", "y", "z", "a",
+	"b", "c", "d", "e", "f", "g", "h", "i",
+	"j", "k", "l", "m", "n", "o", "p", "q",
+	"r", "s", "t", "u", "v", "w", "x", "y",
+	"z", "a", "b", "c", "d", "e", "f", "g",
+	"h", "i", "j", "k", "l", "m", "n", "o",
+	"p", "q", "r", "s", "t", "u", "v", "w",
+	"x", "y", "z", "a", "b", "c", "d", "e",
+	"f", "g", "h", "i", "j", "k", "l", "m",
+	"n", "o", "p", "q", "r", "s", "t", "u",
+	"v", "w", "x", "y", "z", "a", "b", "c",
+	"d", "e", "f", "g", "h", "i", "j", "k",
+	"l", "m", "n", "o", "p", "q", "r", "s",
+	"t", "u", "v", "w", "x", "y", "z", "a",
+	"b", "c", "d", "e", "f", "g", "h", "i",
+	"j", "k", "l", "m", "n", "o", "p", "q",
+	"r", "s", "t", "u", "v", "w", "x", "y",
+	"z", "a", "b", "c", "d", "e", "f", "g",
+	"h", "i", "j", "k", "l", "m", "n", "o",
+	"p", "q", "r", "s", "t", "u", "v", "w",
+	"x", "y", "z", "a", "b", "c", "d", "e",
+	"f", "g", "h", "i", "j", "k", "l", "m",
+	"n", "o", "p", "q", "r", "s", "t", "u",
+	"v", "w", "x", "y", "z", "a", "b", "c",
+	"d", "e", "f", "g", "h", "i", "j", "k",
+	"l", "m", "n", "o", "p", "q", "r", "s",
+	"t", "u", "v", "w", "x", "y", "z", "a",
+	"b", "c", "d", "e", "f", "g", "h", "i",
+	"j", "k", "l", "m", "n", "o", "p", "q",
+	"r", "s", "t", "u", "v", "w", "x", "y",
+	"z", "ı", "ȷ", "α", "β", "γ", "δ", "ε",
+	"ζ", "η", "θ", "ι", "κ", "λ", "μ", "ν",
+	"ξ", "ο", "π", "ρ", "θ", "σ", "τ", "υ",
+	"φ", "χ", "ψ", "ω", "∇", "α", "β", "γ",
+	"δ", "ε", "ζ", "η", "θ", "ι", "κ", "λ",
+	"μ", "ν", "ξ", "ο", "π", "ρ", "σ", "σ",
+	"τ", "υ", "φ", "χ", "ψ", "ω", "∂", "ε",
+	"θ", "κ", "φ", "ρ", "π", "α", "β", "γ",
+	"δ", "ε", "ζ", "η", "θ", "ι", "κ", "λ",
+	"μ", "ν", "ξ", "ο", "π", "ρ", "θ", "σ",
+	"τ", "υ", "φ", "χ", "ψ", "ω", "∇", "α",
+	"β", "γ", "δ", "ε", "ζ", "η", "θ", "ι",
+	"κ", "λ", "μ", "ν", "ξ", "ο", "π", "ρ",
+	"σ", "σ", "τ", "υ", "φ", "χ", "ψ", "ω",
+	"∂", "ε", "θ", "κ", "φ", "ρ", "π", "α",
+	"β", "γ", "δ", "ε", "ζ", "η", "θ", "ι",
+	"κ", "λ", "μ", "ν", "ξ", "ο", "π", "ρ",
+	"θ", "σ", "τ", "υ", "φ", "χ", "ψ", "ω",
+	"∇", "α", "β", "γ", "δ", "ε", "ζ", "η",
+	"θ", "ι", "κ", "λ", "μ", "ν", "ξ", "ο",
+	"π", "ρ", "σ", "σ", "τ", "υ", "φ", "χ",
+	"ψ", "ω", "∂", "ε", "θ", "κ", "φ", "ρ",
+	"π", "α", "β", "γ", "δ", "ε", "ζ", "η",
+	"θ", "ι", "κ", "λ", "μ", "ν", "ξ", "ο",
+	"π", "ρ", "θ", "σ", "τ", "υ", "φ", "χ",
+	"ψ", "ω", "∇", "α", "β", "γ", "δ", "ε",
+	"ζ", "η", "θ", "ι", "κ", "λ", "μ", "ν",
+	"ξ", "ο", "π", "ρ", "σ", "σ", "τ", "υ",
+	"φ", "χ", "ψ", "ω", "∂", "ε", "θ", "κ",
+	"φ", "ρ", "π", "α", "β", "γ", "δ", "ε",
+	"ζ", "η", "θ", "ι", "κ", "λ", "μ", "ν",
+	"ξ", "ο", "π", "ρ", "θ", "σ", "τ", "υ",
+	"φ", "χ", "ψ", "ω", "∇", "α", "β", "γ",
+	"δ", "ε", "ζ", "η", "θ", "ι", "κ", "λ",
+	"μ", "ν", "ξ", "ο", "π", "ρ", "σ", "σ",
+	"τ", "υ", "φ", "χ", "ψ", "ω", "∂", "ε",
+	"θ", "κ", "φ", "ρ", "π", "ϝ", "ϝ", "0",
+	"1", "2", "3", "4", "5", "6", "7", "8",
+	"9", "0", "1", "2", "3", "4", "5", "6",
+	"7", "8", "9", "0", "1", "2", "3", "4",
+	"5", "6", "7", "8", "9", "0", "1", "2",
+	"3", "4", "5", "6", "7", "8", "9", "0",
+	"1", "2", "3", "4", "5", "6", "7", "8",
+	"9", "𞤢", "𞤣", "𞤤", "𞤥", "𞤦", "𞤧", "𞤨",
+	"𞤩", "𞤪", "𞤫", "𞤬", "𞤭", "𞤮", "𞤯", "𞤰",
+	"𞤱", "𞤲", "𞤳", "𞤴", "𞤵", "𞤶", "𞤷", "𞤸",
+	"𞤹", "𞤺", "𞤻", "𞤼", "𞤽", "𞤾", "𞤿", "𞥀",
+	"𞥁", "𞥂", "𞥃", "ا", "ب", "ج", "د", "و",
+	"ز", "ح", "ط", "ي", "ك", "ل", "م", "ن",
+	"س", "ع", "ف", "ص", "ق", "ر", "ش", "ت",
+	"ث", "خ", "ذ", "ض", "ظ", "غ", "ٮ", "ں",
+	"ڡ", "ٯ", "ب", "ج", "ه", "ح", "ي", "ك",
+	"ل", "م", "ن", "س", "ع", "ف", "ص", "ق",
+	"ش", "ت", "ث", "خ", "ض", "غ", "ج", "ح",
+	"ي", "ل", "ن", "س", "ع", "ص", "ق", "ش",
+	"خ", "ض", "غ", "ں", "ٯ", "ب", "ج", "ه",
+	"ح", "ط", "ي", "ك", "م", "ن", "س", "ع",
+	"ف", "ص", "ق", "ش", "ت", "ث", "خ", "ض",
+	"ظ", "غ", "ٮ", "ڡ", "ا", "ب", "ج", "د",
+	"ه", "و", "ز", "ح", "ط", "ي", "ل", "م",
+	"ن", "س", "ع", "ف", "ص", "ق", "ر", "ش",
+	"ت", "ث", "خ", "ذ", "ض", "ظ", "غ", "ب",
+	"ج", "د", "و", "ز", "ح", "ط", "ي", "ل",
+	"م", "ن", "س", "ع", "ف", "ص", "ق", "ر",
+	"ش", "ت", "ث", "خ", "ذ", "ض", "ظ", "غ",
+	"0.", "0,", "1,", "2,", "3,", "4,", "5,", "6,",
+	"7,", "8,", "9,", "(a)", "(b)", "(c)", "(d)", "(e)",
+	"(f)", "(g)", "(h)", "(i)", "(j)", "(k)", "(l)", "(m)",
+	"(n)", "(o)", "(p)", "(q)", "(r)", "(s)", "(t)", "(u)",
+	"(v)", "(w)", "(x)", "(y)", "(z)", "〔s〕", "c", "r",
+	"cd", "wz", "a", "b", "c", "d", "e", "f",
+	"g", "h", "i", "j", "k", "l", "m", "n",
+	"o", "p", "q", "r", "s", "t", "u", "v",
+	"w", "x", "y", "z", "hv", "mv", "sd", "ss",
+	"ppv", "wc", "mc", "md", "mr", "dj", "ほか", "ココ",
+	"サ", "手", "字", "双", "デ", "二", "多", "解",
+	"天", "交", "映", "無", "料", "前", "後", "再",
+	"新", "初", "終", "生", "販", "声", "吹", "演",
+	"投", "捕", "一", "三", "遊", "左", "中", "右",
+	"指", "走", "打", "禁", "空", "合", "満", "有",
+	"月", "申", "割", "営", "配", "〔本〕", "〔三〕", "〔二〕",
+	"〔安〕", "〔点〕", "〔打〕", "〔盗〕", "〔勝〕", "〔敗〕", "得", "可",
+	"0", "1", "2", "3", "4", "5", "6", "7",
+	"8", "9", "丽", "丸", "乁", "𠄢", "你", "侮",
+	"侻", "倂", "偺", "備", "僧", "像", "㒞", "𠘺",
+	"免", "兔", "兤", "具", "𠔜", "㒹", "內", "再",
+	"𠕋", "冗", "冤", "仌", "冬", "况", "𩇟", "凵",
+	"刃", "㓟", "刻", "剆", "割", "剷", "㔕", "勇",
+	"勉", "勤", "勺", "包", "匆", "北", "卉", "卑",
+	"博", "即", "卽", "卿", "卿", "卿", "𠨬", "灰",
+	"及", "叟", "𠭣", "叫", "叱", "吆", "咞", "吸",
+	"呈", "周", "咢", "哶", "唐", "啓", "啣", "善",
+	"善", "喙", "喫", "喳", "嗂", "圖", "嘆", "圗",
+	"噑", "噴", "切", "壮", "城", "埴", "堍", "型",
+	"堲", "報", "墬", "𡓤", "売", "壷", "夆", "多",
+	"夢", "奢", "𡚨", "𡛪", "姬", "娛", "娧", "姘",
+	"婦", "㛮", "㛼", "嬈", "嬾", "嬾", "𡧈", "寃",
+	"寘", "寧", "寳", "𡬘", "寿", "将", "当", "尢",
+	"㞁", "屠", "屮", "峀", "岍", "𡷤", "嵃", "𡷦",
+	"嵮", "嵫", "嵼", "巡", "巢", "㠯", "巽", "帨",
+	"帽", "幩", "㡢", "𢆃", "㡼", "庰", "庳", "庶",
+	"廊", "𪎒", "廾", "𢌱", "𢌱", "舁", "弢", "弢",
+	"㣇", "𣊸", "𦇚", "形", "彫", "㣣", "徚", "忍",
+	"志", "忹", "悁", "㤺", "㤜", "悔", "𢛔", "惇",
+	"慈", "慌", "慎", "慌", "慺", "憎", "憲", "憤",
+	"憯", "懞", "懲", "懶", "成", "戛", "扝", "抱",
+	"拔", "捐", "𢬌", "挽", "拼", "捨", "掃", "揤",
+	"𢯱", "搢", "揅", "掩", "㨮", "摩", "摾", "撝",
+	"摷", "㩬", "敏", "敬", "𣀊", "旣", "書", "晉",
+	"㬙", "暑", "㬈", "㫤", "冒", "冕", "最", "暜",
+	"肭", "䏙", "朗", "望", "朡", "杞", "杓", "𣏃",
+	"㭉", "柺", "枅", "桒", "梅", "𣑭", "梎", "栟",
+	"椔", "㮝", "楂", "榣", "槪", "檨", "𣚣", "櫛",
+	"㰘", "次", "𣢧", "歔", "㱎", "歲", "殟", "殺",
+	"殻", "𣪍", "𡴋", "𣫺", "汎", "𣲼", "沿", "泍",
+	"汧", "洖", "派", "海", "流", "浩", "浸", "涅",
+	"𣴞", "洴", "港", "湮", "㴳", "滋", "滇", "𣻑",
+	"淹", "潮", "𣽞", "𣾎", "濆", "瀹", "瀞", "瀛",
+	"㶖", "灊", "災", "灷", "炭", "𠔥", "煅", "𤉣",
+	"熜", "𤎫", "爨", "爵", "牐", "𤘈", "犀", "犕",
+	"𤜵", "𤠔", "獺", "王", "㺬", "玥", "㺸", "㺸",
+	"瑇", "瑜", "瑱", "璅", "瓊", "㼛", "甤", "𤰶",
+	"甾", "𤲒", "異", "𢆟", "瘐", "𤾡", "𤾸", "𥁄",
+	"㿼", "䀈", "直", "𥃳", "𥃲", "𥄙", "𥄳", "眞",
+	"真", "真", "睊", "䀹", "瞋", "䁆", "䂖", "𥐝",
+	"硎", "碌", "磌", "䃣", "𥘦", "祖", "𥚚", "𥛅",
+	"福", "秫", "䄯", "穀", "穊", "穏", "𥥼", "𥪧",
+	"𥪧", "竮", "䈂", "𥮫", "篆", "築", "䈧", "𥲀",
+	"糒", "䊠", "糨", "糣", "紀", "𥾆", "絣", "䌁",
+	"緇", "縂", "繅", "䌴", "𦈨", "𦉇", "䍙", "𦋙",
+	"罺", "𦌾", "羕", "翺", "者", "𦓚", "𦔣", "聠",
+	"𦖨", "聰", "𣍟", "䏕", "育", "脃", "䐋", "脾",
+	"媵", "𦞧", "𦞵", "𣎓", "𣎜", "舁", "舄", "辞",
+	"䑫", "芑", "芋", "芝", "劳", "花", "芳", "芽",
+	"苦", "𦬼", "若", "茝", "荣", "莭", "茣", "莽",
+	"菧", "著", "荓", "菊", "菌", "菜", "𦰶", "𦵫",
+	"𦳕", "䔫", "蓱", "蓳", "蔖", "𧏊", "蕤", "𦼬",
+	"䕝", "䕡", "𦾱", "𧃒", "䕫", "虐", "虜", "虧",
+	"虩", "蚩", "蚈", "蜎", "蛢", "蝹", "蜨", "蝫",
+	"螆", "䗗", "蟡", "蠁", "䗹", "衠", "衣", "𧙧",
+	"裗", "裞", "䘵", "裺", "㒻", "𧢮", "𧥦", "䚾",
+	"䛇", "誠", "諭", "變", "豕", "𧲨", "貫", "賁",
+	"贛", "起", "𧼯", "𠠄", "跋", "趼", "跰", "𠣞",
+	"軔", "輸", "𨗒", "𨗭", "邔", "郱", "鄑", "𨜮",
+	"鄛", "鈸", "鋗", "鋘", "鉼", "鏹", "鐕", "𨯺",
+	"開", "䦕", "閷", "𨵷", "䧦", "雃", "嶲", "霣",
+	"𩅅", "𩈚", "䩮", "䩶", "韠", "𩐊", "䪲", "𩒖",
+	"頋", "頋", "頩", "𩖶", "飢", "䬳", "餩", "馧",
+	"駂", "駾", "䯎", "𩬰", "鬒", "鱀", "鳽", "䳎",
+	"䳭", "鵧", "𪃎", "䳸", "𪄅", "𪈎", "𪊑", "麻",
+	"䵖", "黹", "黾", "鼅", "鼏", "鼖", "鼻", "𪘀",
+}
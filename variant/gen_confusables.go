@@ -0,0 +1,155 @@
+//go:build ignore
+
+// gen_confusables generates confusables_tables.go from the Unicode confusables
+// data of UTS #39 (https://www.unicode.org/reports/tr39/)
+//
+//	go run gen_confusables.go -src /path/to/confusables.txt
+//
+// confusables.txt is available from https://www.unicode.org/Public/security/latest/
+//
+// A mapping is marked cross-script when its source belongs to a specific script
+// (not Common or Inherited) and its prototype contains a letter of another
+// specific script, as with Cyrillic а → Latin a. Scripts are taken from the
+// unicode package of the Go release running the generator.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// mapping is one confusables.txt entry
+type mapping struct {
+	source rune
+	target string
+	cross  bool
+}
+
+// scriptNames lists the script names in a fixed order
+var scriptNames []string
+
+// scriptOf returns the script of r, or "" when it has none
+func scriptOf(r rune) string {
+	for _, name := range scriptNames {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// specific reports whether a script identifies a writing system
+func specific(script string) bool {
+	return script != "" && script != "Common" && script != "Inherited"
+}
+
+// crossScript reports whether source and target belong to different specific scripts
+func crossScript(source rune, target string) bool {
+	from := scriptOf(source)
+	if !specific(from) {
+		return false
+	}
+	for _, r := range target {
+		if to := scriptOf(r); specific(to) && to != from {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	src := flag.String("src", "", "path to confusables.txt")
+	out := flag.String("out", "confusables_tables.go", "output file")
+	flag.Parse()
+
+	for name := range unicode.Scripts {
+		scriptNames = append(scriptNames, name)
+	}
+	sort.Strings(scriptNames)
+
+	file, err := os.Open(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var mappings []mapping
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			continue
+		}
+
+		source, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 16, 32)
+		if err != nil {
+			log.Fatalf("invalid source in %q", line)
+		}
+		var target strings.Builder
+		for _, field := range strings.Fields(fields[1]) {
+			cp, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				log.Fatalf("invalid target in %q", line)
+			}
+			target.WriteRune(rune(cp))
+		}
+
+		m := mapping{source: rune(source), target: target.String()}
+		m.cross = crossScript(m.source, m.target)
+		mappings = append(mappings, m)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	sort.Slice(mappings, func(i, j int) bool { return mappings[i].source < mappings[j].source })
+
+	var keys, values, cross bytes.Buffer
+	crossCount := 0
+	for i, m := range mappings {
+		fmt.Fprintf(&keys, "0x%04X,", m.source)
+		fmt.Fprintf(&values, "%+q,", m.target)
+		if m.cross {
+			cross.WriteByte('1')
+			crossCount++
+		} else {
+			cross.WriteByte('0')
+		}
+		if (i+1)%8 == 0 {
+			keys.WriteByte('\n')
+			values.WriteByte('\n')
+		}
+	}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// Code generated by gen_confusables.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package variant\n\n")
+	fmt.Fprintf(&source, "// confusableKeys lists the code points with a confusables prototype, in ascending order\n")
+	fmt.Fprintf(&source, "var confusableKeys = [...]rune{\n%s}\n\n", keys.String())
+	fmt.Fprintf(&source, "// confusableValues holds the prototype of each of confusableKeys\n")
+	fmt.Fprintf(&source, "var confusableValues = [...]string{\n%s}\n\n", values.String())
+	fmt.Fprintf(&source, "// confusableCross marks with '1' the mappings of confusableKeys that cross scripts\n")
+	fmt.Fprintf(&source, "const confusableCross = %q\n", cross.String())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("wrote %d mappings, %d cross-script\n", len(mappings), crossCount)
+}
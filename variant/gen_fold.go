@@ -0,0 +1,206 @@
+//go:build ignore
+
+// gen_fold generates fold_tables.go from the Unicode Character Database
+//
+//	go run gen_fold.go -ucd /path/to/ucd
+//
+// The directory must hold UnicodeData.txt and CaseFolding.txt, available from
+// https://www.unicode.org/Public/UCD/latest/ucd/
+//
+// Every code point folds to:
+//   - its compatibility decomposition (full-width, circled, mathematical, ...)
+//   - its canonical decomposition when everything after the base is a combining diacritic
+//   - with combining diacritics removed
+//   - and full case folding applied
+//
+// repeated until nothing changes. Code points that fold to themselves are omitted.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// decomposition is one UnicodeData.txt decomposition mapping
+type decomposition struct {
+	compat bool
+	runes  []rune
+}
+
+var (
+	decompositions = make(map[rune]decomposition)
+	foldings       = make(map[rune][]rune)
+)
+
+// isDiacritic reports whether r is a combining diacritic that folding removes
+func isDiacritic(r rune) bool {
+	return (r >= 0x0300 && r <= 0x036F) || // Combining Diacritical Marks
+		(r >= 0x1AB0 && r <= 0x1AFF) || // Combining Diacritical Marks Extended
+		(r >= 0x1DC0 && r <= 0x1DFF) || // Combining Diacritical Marks Supplement
+		(r >= 0x20D0 && r <= 0x20FF) || // Combining Diacritical Marks for Symbols
+		(r >= 0xFE20 && r <= 0xFE2F) // Combining Half Marks
+}
+
+// parseRunes parses space-separated hexadecimal code points
+func parseRunes(s string) []rune {
+	var runes []rune
+	for _, field := range strings.Fields(s) {
+		cp, err := strconv.ParseUint(field, 16, 32)
+		if err != nil {
+			log.Fatalf("invalid code point %q", field)
+		}
+		runes = append(runes, rune(cp))
+	}
+	return runes
+}
+
+// readLines calls fn with the semicolon-separated fields of every data line of a UCD file
+func readLines(path string, fn func(fields []string)) {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		fn(fields)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// step folds r once
+func step(r rune) []rune {
+	if isDiacritic(r) {
+		return nil
+	}
+
+	if d, exists := decompositions[r]; exists {
+		canonicalDiacritics := true
+		for _, mark := range d.runes[1:] {
+			if !isDiacritic(mark) {
+				canonicalDiacritics = false
+			}
+		}
+		if d.compat || canonicalDiacritics {
+			return d.runes
+		}
+	}
+
+	if folded, exists := foldings[r]; exists {
+		return folded
+	}
+	return []rune{r}
+}
+
+// fold folds r until nothing changes
+func fold(r rune) []rune {
+	current := []rune{r}
+	for round := 0; round < 16; round++ {
+		var next []rune
+		for _, c := range current {
+			next = append(next, step(c)...)
+		}
+		if string(next) == string(current) {
+			return current
+		}
+		current = next
+	}
+	log.Fatalf("U+%04X does not converge", r)
+	return nil
+}
+
+func main() {
+	ucd := flag.String("ucd", "", "directory holding UnicodeData.txt and CaseFolding.txt")
+	out := flag.String("out", "fold_tables.go", "output file")
+	flag.Parse()
+
+	var points []rune
+	readLines(filepath.Join(*ucd, "UnicodeData.txt"), func(fields []string) {
+		if len(fields) < 6 {
+			return
+		}
+		r := parseRunes(fields[0])[0]
+		points = append(points, r)
+
+		mapping := fields[5]
+		if mapping == "" {
+			return
+		}
+		d := decomposition{}
+		if strings.HasPrefix(mapping, "<") {
+			d.compat = true
+			mapping = mapping[strings.IndexByte(mapping, '>')+1:]
+		}
+		d.runes = parseRunes(mapping)
+		decompositions[r] = d
+	})
+
+	readLines(filepath.Join(*ucd, "CaseFolding.txt"), func(fields []string) {
+		if len(fields) < 3 || (fields[1] != "C" && fields[1] != "F") {
+			return
+		}
+		foldings[parseRunes(fields[0])[0]] = parseRunes(fields[2])
+	})
+
+	sort.Slice(points, func(i, j int) bool { return points[i] < points[j] })
+
+	var keys bytes.Buffer
+	var values bytes.Buffer
+	count := 0
+	for _, r := range points {
+		if r >= 0xD800 && r <= 0xDFFF {
+			continue
+		}
+		folded := fold(r)
+		if string(folded) == string(r) {
+			continue
+		}
+		fmt.Fprintf(&keys, "0x%04X,", r)
+		fmt.Fprintf(&values, "%q,", string(folded))
+		count++
+		if count%8 == 0 {
+			keys.WriteByte('\n')
+			values.WriteByte('\n')
+		}
+	}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// Code generated by gen_fold.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package variant\n\n")
+	fmt.Fprintf(&source, "// foldKeys lists the code points that fold to something else, in ascending order\n")
+	fmt.Fprintf(&source, "var foldKeys = [...]rune{\n%s}\n\n", keys.String())
+	fmt.Fprintf(&source, "// foldValues holds what each of foldKeys folds to\n")
+	fmt.Fprintf(&source, "var foldValues = [...]string{\n%s}\n", values.String())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("wrote %d mappings\n", count)
+}
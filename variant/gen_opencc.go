@@ -0,0 +1,130 @@
+//go:build ignore
+
+// gen_opencc generates opencc.dat from the text dictionaries of OpenCC
+// (Apache-2.0, https://github.com/BYVoid/OpenCC)
+//
+//	go run gen_opencc.go -src /path/to/OpenCC/data/dictionary
+//
+// opencc.dat layout, all integers big-endian:
+//
+//	"CCv1"
+//	uint16 dictionary count
+//	dictionary count × (uint8 name length, name, uint32 offset, uint32 length)
+//	DEFLATE-compressed dictionaries
+//
+// A decompressed dictionary holds one "key\tvalue" line per entry; only the
+// first (default) candidate of each OpenCC entry is kept.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// dictionaries lists the OpenCC dictionaries used by the conversions in opencc.go
+var dictionaries = []string{
+	"STCharacters",
+	"STPhrases",
+	"TSCharacters",
+	"TSPhrases",
+	"TWVariants",
+	"TWVariantsRev",
+	"TWVariantsRevPhrases",
+	"TWPhrases",
+	"TWPhrasesRev",
+	"HKVariants",
+	"HKVariantsRev",
+	"HKVariantsRevPhrases",
+}
+
+// readDictionary returns the entries of an OpenCC text dictionary in file order
+// Later entries for the same key replace earlier ones
+func readDictionary(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	var keys []string
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		candidates := strings.Fields(fields[1])
+		if len(candidates) == 0 {
+			continue
+		}
+		if _, exists := values[fields[0]]; !exists {
+			keys = append(keys, fields[0])
+		}
+		values[fields[0]] = candidates[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(key)
+		b.WriteByte('\t')
+		b.WriteString(values[key])
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
+
+func main() {
+	src := flag.String("src", "", "OpenCC data/dictionary directory")
+	out := flag.String("out", "opencc.dat", "output file")
+	flag.Parse()
+
+	var data bytes.Buffer
+	var index bytes.Buffer
+	for _, name := range dictionaries {
+		text, err := readDictionary(filepath.Join(*src, name+".txt"))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var compressed bytes.Buffer
+		writer, err := flate.NewWriter(&compressed, flate.BestCompression)
+		if err != nil {
+			log.Fatal(err)
+		}
+		writer.Write([]byte(text))
+		writer.Close()
+
+		index.WriteByte(byte(len(name)))
+		index.WriteString(name)
+		binary.Write(&index, binary.BigEndian, uint32(data.Len()))
+		binary.Write(&index, binary.BigEndian, uint32(compressed.Len()))
+		data.Write(compressed.Bytes())
+	}
+
+	var output bytes.Buffer
+	output.WriteString("CCv1")
+	binary.Write(&output, binary.BigEndian, uint16(len(dictionaries)))
+	output.Write(index.Bytes())
+	output.Write(data.Bytes())
+
+	if err := os.WriteFile(*out, output.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("wrote %d dictionaries, %d bytes\n", len(dictionaries), output.Len())
+}
@@ -0,0 +1,153 @@
+//go:build ignore
+
+// gen_pinyin generates pinyin.dat from the pinyin_dict.go table of
+// github.com/mozillazg/go-pinyin (MIT, data from github.com/mozillazg/pinyin-data)
+//
+//	go run gen_pinyin.go -src /path/to/go-pinyin/pinyin_dict.go
+//
+// pinyin.dat layout, all integers big-endian:
+//
+//	"PYv1"
+//	uint16 page count
+//	page count × (uint16 page, uint32 offset, uint32 length)
+//	DEFLATE-compressed pages
+//
+// A page covers 256 code points starting at page<<8. Decompressed, it holds 256
+// lines, one per code point, each a comma-separated list of tone-number readings
+// such as "zhong1,zhong4"; the neutral tone is written as 5 and ü as v.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// toneMarks maps vowels with tone marks to their base letter and tone
+var toneMarks = map[rune]struct {
+	base string
+	tone int
+}{
+	'ā': {"a", 1}, 'á': {"a", 2}, 'ǎ': {"a", 3}, 'à': {"a", 4},
+	'ē': {"e", 1}, 'é': {"e", 2}, 'ě': {"e", 3}, 'è': {"e", 4},
+	'ī': {"i", 1}, 'í': {"i", 2}, 'ǐ': {"i", 3}, 'ì': {"i", 4},
+	'ō': {"o", 1}, 'ó': {"o", 2}, 'ǒ': {"o", 3}, 'ò': {"o", 4},
+	'ū': {"u", 1}, 'ú': {"u", 2}, 'ǔ': {"u", 3}, 'ù': {"u", 4},
+	'ǖ': {"v", 1}, 'ǘ': {"v", 2}, 'ǚ': {"v", 3}, 'ǜ': {"v", 4}, 'ü': {"v", 0},
+	'ń': {"n", 2}, 'ň': {"n", 3}, 'ǹ': {"n", 4}, 'ḿ': {"m", 2},
+	'ế': {"e", 2}, 'ề': {"e", 4}, 'ê': {"e", 0},
+	'̄': {"", 1}, '́': {"", 2}, '̌': {"", 3}, '̀': {"", 4},
+}
+
+// toneNumber converts a reading with tone marks to its tone-number form
+func toneNumber(reading string) (string, error) {
+	var b strings.Builder
+	tone := 0
+	for _, r := range reading {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		mark, ok := toneMarks[r]
+		if !ok {
+			return "", fmt.Errorf("unknown character %q in %q", r, reading)
+		}
+		b.WriteString(mark.base)
+		if mark.tone != 0 {
+			tone = mark.tone
+		}
+	}
+	if tone == 0 {
+		tone = 5
+	}
+	return b.String() + strconv.Itoa(tone), nil
+}
+
+func main() {
+	src := flag.String("src", "", "path to go-pinyin pinyin_dict.go")
+	out := flag.String("out", "pinyin.dat", "output file")
+	flag.Parse()
+
+	file, err := os.Open(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	entry := regexp.MustCompile(`^\s*0x([0-9A-Fa-f]+):\s*"([^"]*)"`)
+	pages := make(map[int]*[256]string)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		m := entry.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		cp, err := strconv.ParseInt(m[1], 16, 32)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		readings := make([]string, 0, 4)
+		for _, reading := range strings.Split(m[2], ",") {
+			converted, err := toneNumber(strings.TrimSpace(reading))
+			if err != nil {
+				log.Fatalf("U+%04X: %v", cp, err)
+			}
+			readings = append(readings, converted)
+		}
+
+		page := int(cp >> 8)
+		if pages[page] == nil {
+			pages[page] = new([256]string)
+		}
+		pages[page][cp&0xFF] = strings.Join(readings, ",")
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	numbers := make([]int, 0, len(pages))
+	for page := range pages {
+		numbers = append(numbers, page)
+	}
+	sort.Ints(numbers)
+
+	var data bytes.Buffer
+	var index bytes.Buffer
+	for _, page := range numbers {
+		var compressed bytes.Buffer
+		writer, err := flate.NewWriter(&compressed, flate.BestCompression)
+		if err != nil {
+			log.Fatal(err)
+		}
+		writer.Write([]byte(strings.Join(pages[page][:], "\n")))
+		writer.Close()
+
+		binary.Write(&index, binary.BigEndian, uint16(page))
+		binary.Write(&index, binary.BigEndian, uint32(data.Len()))
+		binary.Write(&index, binary.BigEndian, uint32(compressed.Len()))
+		data.Write(compressed.Bytes())
+	}
+
+	var output bytes.Buffer
+	output.WriteString("PYv1")
+	binary.Write(&output, binary.BigEndian, uint16(len(numbers)))
+	output.Write(index.Bytes())
+	output.Write(data.Bytes())
+
+	if err := os.WriteFile(*out, output.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("wrote %d pages, %d bytes\n", len(numbers), output.Len())
+}
@@ -0,0 +1,85 @@
+package variant
+
+import (
+	"strings"
+	"unicode"
+)
+
+// InvisibleProcessor removes invisible and format characters that are used to
+// split words, such as zero-width spaces and joiners, byte order marks,
+// variation selectors, bidi controls and tag characters
+// Unlike SymbolProcessor it keeps punctuation and every visible character
+type InvisibleProcessor struct{}
+
+// NewInvisibleProcessor creates a new invisible character processor
+func NewInvisibleProcessor() *InvisibleProcessor {
+	return &InvisibleProcessor{}
+}
+
+// Process removes invisible characters from text
+func (p *InvisibleProcessor) Process(text string) string {
+	return RemoveInvisible(text)
+}
+
+// ProcessWithOffsets removes invisible characters and maps the kept runes to their input positions
+func (p *InvisibleProcessor) ProcessWithOffsets(text string) (string, []int) {
+	var builder strings.Builder
+	builder.Grow(len(text))
+	offsets := make([]int, 0, len(text))
+
+	i := 0
+	for _, r := range text {
+		if !IsInvisible(r) {
+			builder.WriteRune(r)
+			offsets = append(offsets, i)
+		}
+		i++
+	}
+
+	return builder.String(), offsets
+}
+
+// Name returns the processor name
+func (p *InvisibleProcessor) Name() string {
+	return "invisible"
+}
+
+// IsInvisible reports whether r renders as nothing: a format character
+// (Unicode category Cf) or one of the blank or combining characters commonly
+// abused to hide text
+func IsInvisible(r rune) bool {
+	switch {
+	case r < 0x80:
+		return false
+	case unicode.Is(unicode.Cf, r): // Zero-width space/joiners, BOM, bidi controls, tags, soft hyphen
+		return true
+	case r >= 0xFE00 && r <= 0xFE0F: // Variation selectors
+		return true
+	case r >= 0xE0100 && r <= 0xE01EF: // Variation selectors supplement
+		return true
+	case r >= 0x180B && r <= 0x180F: // Mongolian free variation selectors and vowel separator
+		return true
+	}
+
+	switch r {
+	case 0x034F, // Combining grapheme joiner
+		0x115F, 0x1160, 0x3164, 0xFFA0, // Hangul fillers
+		0x17B4, 0x17B5, // Khmer inherent vowels
+		0x2800: // Braille pattern blank
+		return true
+	}
+	return false
+}
+
+// RemoveInvisible is a utility function to remove invisible characters from text
+func RemoveInvisible(text string) string {
+	if strings.IndexFunc(text, IsInvisible) < 0 {
+		return text
+	}
+	return strings.Map(func(r rune) rune {
+		if IsInvisible(r) {
+			return -1
+		}
+		return r
+	}, text)
+}
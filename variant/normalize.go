@@ -0,0 +1,86 @@
+package variant
+
+import (
+	"sort"
+	"strings"
+)
+
+// NormalizeProcessor folds look-alike encodings of the same text
+// Full-width, circled and mathematical letters become plain ones, combining
+// diacritics are removed and full Unicode case folding is applied, so "ｂａｄ",
+// "ⓑⓐⓓ", "𝐛𝐚𝐝" and "BÁD" all become "bad". The tables are generated by
+// gen_fold.go from the Unicode Character Database
+type NormalizeProcessor struct{}
+
+// NewNormalizeProcessor creates a new normalization processor
+func NewNormalizeProcessor() *NormalizeProcessor {
+	return &NormalizeProcessor{}
+}
+
+// Process folds text for matching
+func (p *NormalizeProcessor) Process(text string) string {
+	return Fold(text)
+}
+
+// ProcessWithOffsets folds text and maps every output rune to the input rune it came from
+// Runes that fold to several runes, such as "ﬁ" to "fi", map each of them to the input rune;
+// removed diacritics produce no output
+func (p *NormalizeProcessor) ProcessWithOffsets(text string) (string, []int) {
+	var builder strings.Builder
+	builder.Grow(len(text))
+	offsets := make([]int, 0, len(text))
+
+	i := 0
+	for _, r := range text {
+		if folded, exists := foldRune(r); exists {
+			builder.WriteString(folded)
+			for range folded {
+				offsets = append(offsets, i)
+			}
+		} else {
+			builder.WriteRune(r)
+			offsets = append(offsets, i)
+		}
+		i++
+	}
+
+	return builder.String(), offsets
+}
+
+// Name returns the processor name
+func (p *NormalizeProcessor) Name() string {
+	return "normalize"
+}
+
+// Fold applies compatibility folding, diacritic removal and case folding to text
+func Fold(text string) string {
+	var builder strings.Builder
+	builder.Grow(len(text))
+
+	for _, r := range text {
+		if folded, exists := foldRune(r); exists {
+			builder.WriteString(folded)
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// foldRune returns what r folds to, if it changes
+func foldRune(r rune) (string, bool) {
+	// Fast path for ASCII, where only capital letters fold
+	if r < 0x80 {
+		if r >= 'A' && r <= 'Z' {
+			return string(r + 'a' - 'A'), true
+		}
+		return "", false
+	}
+
+	i := sort.Search(len(foldKeys), func(i int) bool { return foldKeys[i] >= r })
+	if i < len(foldKeys) && foldKeys[i] == r {
+		return foldValues[i], true
+	}
+	return "", false
+}
@@ -0,0 +1,326 @@
+package variant
+
+import (
+	"bytes"
+	"compress/flate"
+	_ "embed"
+	"encoding/binary"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// openccData holds the OpenCC dictionaries generated by gen_opencc.go
+//
+//go:embed opencc.dat
+var openccData []byte
+
+// Conversion is a simplified/traditional Chinese conversion, following the OpenCC configurations
+type Conversion int
+
+const (
+	// ConvertT2S converts Traditional Chinese, including Taiwan and Hong Kong variants, to Simplified
+	ConvertT2S Conversion = iota
+	// ConvertS2T converts Simplified Chinese to Traditional (OpenCC standard)
+	ConvertS2T
+	// ConvertS2TW converts Simplified Chinese to Traditional with Taiwan variants
+	ConvertS2TW
+	// ConvertS2TWP converts Simplified Chinese to Traditional with Taiwan variants and vocabulary
+	ConvertS2TWP
+	// ConvertS2HK converts Simplified Chinese to Traditional with Hong Kong variants
+	ConvertS2HK
+	// ConvertTW2S converts Traditional Chinese with Taiwan variants to Simplified
+	ConvertTW2S
+	// ConvertTW2SP converts Traditional Chinese with Taiwan variants and vocabulary to Simplified
+	ConvertTW2SP
+	// ConvertHK2S converts Traditional Chinese with Hong Kong variants to Simplified
+	ConvertHK2S
+)
+
+// conversionChains lists the dictionary groups applied by each conversion, in order
+var conversionChains = map[Conversion][][]string{
+	ConvertT2S: {
+		{"TWVariantsRevPhrases", "TWVariantsRev", "HKVariantsRevPhrases", "HKVariantsRev"},
+		{"TSPhrases", "TSCharacters"},
+	},
+	ConvertS2T: {
+		{"STPhrases", "STCharacters"},
+	},
+	ConvertS2TW: {
+		{"STPhrases", "STCharacters"},
+		{"TWVariants"},
+	},
+	ConvertS2TWP: {
+		{"STPhrases", "STCharacters"},
+		{"TWPhrases"},
+		{"TWVariants"},
+	},
+	ConvertS2HK: {
+		{"STPhrases", "STCharacters"},
+		{"HKVariants"},
+	},
+	ConvertTW2S: {
+		{"TWVariantsRevPhrases", "TWVariantsRev"},
+		{"TSPhrases", "TSCharacters"},
+	},
+	ConvertTW2SP: {
+		{"TWPhrasesRev", "TWVariantsRevPhrases", "TWVariantsRev"},
+		{"TSPhrases", "TSCharacters"},
+	},
+	ConvertHK2S: {
+		{"HKVariantsRevPhrases", "HKVariantsRev"},
+		{"TSPhrases", "TSCharacters"},
+	},
+}
+
+// String returns the OpenCC name of the conversion
+func (c Conversion) String() string {
+	switch c {
+	case ConvertT2S:
+		return "t2s"
+	case ConvertS2T:
+		return "s2t"
+	case ConvertS2TW:
+		return "s2tw"
+	case ConvertS2TWP:
+		return "s2twp"
+	case ConvertS2HK:
+		return "s2hk"
+	case ConvertTW2S:
+		return "tw2s"
+	case ConvertTW2SP:
+		return "tw2sp"
+	case ConvertHK2S:
+		return "hk2s"
+	default:
+		return "unknown"
+	}
+}
+
+// ccDict is one OpenCC dictionary
+type ccDict struct {
+	entries map[string]string
+	longest map[rune]int // First rune of a key -> longest key starting with it, in runes
+}
+
+// lookup returns the value of the longest key that prefixes text, with the key
+// length in runes; ends holds the byte offsets after each leading rune of text
+func (d *ccDict) lookup(text string, first rune, ends []int) (string, int) {
+	n := d.longest[first]
+	if n > len(ends) {
+		n = len(ends)
+	}
+	for ; n > 0; n-- {
+		if value, exists := d.entries[text[:ends[n-1]]]; exists {
+			return value, n
+		}
+	}
+	return "", 0
+}
+
+// ccTable decompresses dictionaries of openccData on first use
+type ccTable struct {
+	once  sync.Once
+	index map[string][2]uint32 // Dictionary name -> offset and length in data
+	data  []byte
+
+	mu    sync.RWMutex
+	dicts map[string]*ccDict
+}
+
+var ccTab = &ccTable{}
+
+// init parses the dictionary index of the embedded data
+func (t *ccTable) init() {
+	t.index = make(map[string][2]uint32)
+	t.dicts = make(map[string]*ccDict)
+
+	if len(openccData) < 6 || string(openccData[:4]) != "CCv1" {
+		return
+	}
+	count := int(binary.BigEndian.Uint16(openccData[4:6]))
+	pos := 6
+	for i := 0; i < count; i++ {
+		if pos >= len(openccData) {
+			return
+		}
+		length := int(openccData[pos])
+		if pos+1+length+8 > len(openccData) {
+			return
+		}
+		name := string(openccData[pos+1 : pos+1+length])
+		entry := openccData[pos+1+length:]
+		t.index[name] = [2]uint32{binary.BigEndian.Uint32(entry), binary.BigEndian.Uint32(entry[4:])}
+		pos += 1 + length + 8
+	}
+	t.data = openccData[pos:]
+}
+
+// dict returns a dictionary by name, loading it if needed
+func (t *ccTable) dict(name string) *ccDict {
+	t.once.Do(t.init)
+
+	t.mu.RLock()
+	d, loaded := t.dicts[name]
+	t.mu.RUnlock()
+	if loaded {
+		return d
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.load(name)
+}
+
+// load returns a dictionary by name, decompressing it if needed; callers must hold mu
+// The reverse variant dictionaries, used only on the way to simplified Chinese,
+// lose the entries for characters that are already simplified, such as 么, so
+// simplified text passes through unchanged
+func (t *ccTable) load(name string) *ccDict {
+	if d, loaded := t.dicts[name]; loaded {
+		return d
+	}
+
+	d := &ccDict{entries: make(map[string]string), longest: make(map[rune]int)}
+	t.dicts[name] = d
+
+	location, exists := t.index[name]
+	if !exists || uint64(location[0])+uint64(location[1]) > uint64(len(t.data)) {
+		return d
+	}
+	reader := flate.NewReader(bytes.NewReader(t.data[location[0] : location[0]+location[1]]))
+	defer reader.Close()
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return d
+	}
+
+	var simplified map[string]string
+	if strings.HasSuffix(name, "VariantsRev") {
+		simplified = t.load("STCharacters").entries
+	}
+
+	for _, line := range strings.Split(string(raw), "\n") {
+		tab := strings.IndexByte(line, '\t')
+		if tab <= 0 {
+			continue
+		}
+		key := line[:tab]
+		if _, exists := simplified[key]; exists {
+			continue
+		}
+		d.entries[key] = line[tab+1:]
+
+		first, _ := utf8.DecodeRuneInString(key)
+		if n := utf8.RuneCountInString(key); n > d.longest[first] {
+			d.longest[first] = n
+		}
+	}
+	return d
+}
+
+// Convert converts text between simplified and traditional Chinese
+// Each step of the conversion replaces the longest phrase or character found in
+// its dictionaries, so phrases such as 头发/頭髮 and 发展/發展 convert correctly
+func Convert(text string, conversion Conversion) string {
+	output, _ := ConvertWithOffsets(text, conversion)
+	return output
+}
+
+// ConvertWithOffsets converts text like Convert and returns, for every output
+// rune, the index of the input rune it was converted from
+// When a phrase converts to one of a different length, its output runes are
+// spread evenly over its input runes, the first and last runes mapping to the
+// first and last input runes
+func ConvertWithOffsets(text string, conversion Conversion) (string, []int) {
+	return convertChain(text, resolveChain(conversion))
+}
+
+// resolveChain returns the dictionary groups of a conversion, loading them if needed
+func resolveChain(conversion Conversion) [][]*ccDict {
+	groups := conversionChains[conversion]
+	chain := make([][]*ccDict, len(groups))
+	for i, group := range groups {
+		chain[i] = make([]*ccDict, len(group))
+		for j, name := range group {
+			chain[i][j] = ccTab.dict(name)
+		}
+	}
+	return chain
+}
+
+// convertChain applies the dictionary groups of a conversion in order
+func convertChain(text string, chain [][]*ccDict) (string, []int) {
+	offsets := identityOffsets(utf8.RuneCountInString(text))
+	for _, dicts := range chain {
+		var stepOffsets []int
+		text, stepOffsets = convertStep(text, dicts)
+
+		composed := make([]int, len(stepOffsets))
+		for i, offset := range stepOffsets {
+			composed[i] = offsets[offset]
+		}
+		offsets = composed
+	}
+	return text, offsets
+}
+
+// convertStep applies one dictionary group by forward maximum matching
+// The longest key across the group wins; on equal lengths the earlier dictionary wins
+func convertStep(text string, dicts []*ccDict) (string, []int) {
+	var builder strings.Builder
+	builder.Grow(len(text))
+	offsets := make([]int, 0, len(text))
+
+	ends := make([]int, 0, 16)
+	index := 0
+	for pos := 0; pos < len(text); {
+		first, size := utf8.DecodeRuneInString(text[pos:])
+
+		longest := 0
+		for _, d := range dicts {
+			if d.longest[first] > longest {
+				longest = d.longest[first]
+			}
+		}
+
+		// Byte offsets after each of the next runes, up to the longest key
+		ends = ends[:0]
+		for end := pos; end < len(text) && len(ends) < longest; {
+			_, n := utf8.DecodeRuneInString(text[end:])
+			end += n
+			ends = append(ends, end-pos)
+		}
+
+		value, length := "", 0
+		for _, d := range dicts {
+			if v, n := d.lookup(text[pos:], first, ends); n > length {
+				value, length = v, n
+			}
+		}
+
+		if length == 0 {
+			builder.WriteRune(first)
+			offsets = append(offsets, index)
+			pos += size
+			index++
+			continue
+		}
+
+		count := utf8.RuneCountInString(value)
+		builder.WriteString(value)
+		for j := 0; j < count; j++ {
+			if count == 1 {
+				offsets = append(offsets, index)
+			} else {
+				offsets = append(offsets, index+j*(length-1)/(count-1))
+			}
+		}
+
+		pos += ends[length-1]
+		index += length
+	}
+
+	return builder.String(), offsets
+}
@@ -5,32 +5,43 @@ import (
 )
 
 // PinyinProcessor handles pinyin variant detection
-type PinyinProcessor struct {
-	// pinyinMap maps characters to their pinyin representation
-	pinyinMap map[rune]string
-}
+// Characters are replaced with their most common tone-less reading from the
+// embedded pinyin table
+type PinyinProcessor struct{}
 
 // NewPinyinProcessor creates a new pinyin processor
 func NewPinyinProcessor() *PinyinProcessor {
-	return &PinyinProcessor{
-		pinyinMap: buildBasicPinyinMap(),
-	}
+	return &PinyinProcessor{}
 }
 
 // Process converts Chinese characters to pinyin for matching
 func (p *PinyinProcessor) Process(text string) string {
+	output, _ := p.ProcessWithOffsets(text)
+	return output
+}
+
+// ProcessWithOffsets converts Chinese characters to pinyin and maps every
+// letter of a syllable back to its character
+func (p *PinyinProcessor) ProcessWithOffsets(text string) (string, []int) {
 	var builder strings.Builder
 	builder.Grow(len(text))
+	offsets := make([]int, 0, len(text))
 
+	i := 0
 	for _, r := range text {
-		if py, exists := p.pinyinMap[r]; exists {
-			builder.WriteString(py)
+		if readings := Pinyin(r, PinyinPlain); len(readings) > 0 {
+			builder.WriteString(readings[0])
+			for range readings[0] {
+				offsets = append(offsets, i)
+			}
 		} else {
 			builder.WriteRune(r)
+			offsets = append(offsets, i)
 		}
+		i++
 	}
 
-	return builder.String()
+	return builder.String(), offsets
 }
 
 // Name returns the processor name
@@ -38,57 +49,143 @@ func (p *PinyinProcessor) Name() string {
 	return "pinyin"
 }
 
-// buildBasicPinyinMap builds a basic pinyin mapping
-// In a production implementation, this would use a complete pinyin library
-func buildBasicPinyinMap() map[rune]string {
-	// Extended basic pinyin mappings for common characters
-	return map[rune]string{
-		// Common words
-		'傻': "sha", '比': "bi", '测': "ce", '试': "shi", '敏': "min", '感': "gan", '词': "ci",
-
-		// Numbers
-		'一': "yi", '二': "er", '三': "san", '四': "si", '五': "wu",
-		'六': "liu", '七': "qi", '八': "ba", '九': "jiu", '十': "shi",
-
-		// Common characters (100 most frequent)
-		'的': "de", '了': "le", '是': "shi", '我': "wo", '不': "bu",
-		'在': "zai", '人': "ren", '有': "you", '他': "ta", '这': "zhe",
-		'个': "ge", '们': "men", '中': "zhong", '来': "lai", '上': "shang",
-		'大': "da", '为': "wei", '和': "he", '国': "guo", '地': "di",
-		'到': "dao", '以': "yi", '说': "shuo", '时': "shi", '要': "yao",
-		'就': "jiu", '出': "chu", '会': "hui", '可': "ke", '也': "ye",
-		'你': "ni", '对': "dui", '生': "sheng", '能': "neng", '而': "er",
-		'子': "zi", '那': "na", '得': "de", '于': "yu", '着': "zhe",
-		'下': "xia", '自': "zi", '之': "zhi", '年': "nian", '过': "guo",
-		'发': "fa", '后': "hou", '作': "zuo", '里': "li", '用': "yong",
-		'道': "dao", '行': "xing", '所': "suo", '然': "ran", '家': "jia",
-		'种': "zhong", '事': "shi", '成': "cheng", '方': "fang", '多': "duo",
-		'经': "jing", '么': "me", '去': "qu", '法': "fa", '学': "xue",
-		'如': "ru", '她': "ta", '只': "zhi", '现': "xian", '当': "dang",
-		'样': "yang", '看': "kan", '文': "wen", '无': "wu", '开': "kai",
-		'手': "shou", '主': "zhu",
-		'又': "you", '高': "gao", '小': "xiao", '动': "dong",
-		'部': "bu", '机': "ji", '问': "wen", '分': "fen",
-
-		// Sensitive word related
-		'政': "zheng", '治': "zhi", '色': "se", '情': "qing", '暴': "bao",
-		'毒': "du", '品': "pin", '赌': "du", '博': "bo",
-		'枪': "qiang", '支': "zhi", '弹': "dan", '药': "yao", '死': "si",
-		'杀': "sha", '血': "xue", '腥': "xing", '恐': "kong", '怖': "bu",
+// PinyinVariants returns the pinyin spellings of a dictionary word
+// Full spellings such as "shabi" come first, followed by mixed forms that keep
+// at least one character, such as "sha比", "傻bi" and "傻b"; forms closer to the
+// word and to the common readings of its heteronyms come earlier. Full spellings
+// take at most half of the limit so that mixed forms are always generated; at
+// most limit forms are returned (DefaultPinyinLimit when limit is 0 or less)
+func PinyinVariants(word string, limit int) []string {
+	if limit <= 0 {
+		limit = DefaultPinyinLimit
+	}
+
+	// Choices per character, cheapest first: the character itself, its common
+	// reading and that reading's initial, then the other readings and initials
+	runes := []rune(word)
+	choices := make([][]string, len(runes))
+	for i, r := range runes {
+		choices[i] = []string{string(r)}
+		readings := Pinyin(r, PinyinPlain)
+		if len(readings) == 0 {
+			continue
+		}
+		choices[i] = append(choices[i], readings[0], readings[0][:1])
+		for _, reading := range readings[1:] {
+			choices[i] = appendUnique(choices[i], reading)
+		}
+		for _, reading := range readings[1:] {
+			choices[i] = appendUnique(choices[i], reading[:1])
+		}
+	}
+
+	// Full spellings take at most half of the limit when mixed forms are possible
+	budget := limit
+	if len(runes) > 1 {
+		budget = (limit + 1) / 2
+	}
+	variants := make([]string, 0, limit)
+	for _, full := range PinyinReadings(word, PinyinPlain, budget) {
+		if full != word {
+			variants = appendUnique(variants, full)
+		}
+	}
+
+	// Mixed forms in order of total choice cost; suffix[i] is the highest cost
+	// the characters from i on can add
+	suffix := make([]int, len(runes)+1)
+	for i := len(runes) - 1; i >= 0; i-- {
+		suffix[i] = suffix[i+1] + len(choices[i]) - 1
+	}
+
+	parts := make([]string, len(runes))
+	var mix func(i, cost, kept int)
+	mix = func(i, cost, kept int) {
+		if len(variants) >= limit {
+			return
+		}
+		if i == len(runes) {
+			if cost == 0 && kept > 0 {
+				variants = appendUnique(variants, strings.Join(parts, ""))
+			}
+			return
+		}
+		for pick := 0; pick < len(choices[i]) && pick <= cost; pick++ {
+			if cost-pick > suffix[i+1] {
+				continue
+			}
+			parts[i] = choices[i][pick]
+			if pick == 0 && len(choices[i]) > 1 {
+				mix(i+1, cost-pick, kept+1)
+			} else {
+				mix(i+1, cost-pick, kept)
+			}
+		}
+	}
+	for cost := 1; cost <= suffix[0] && len(variants) < limit; cost++ {
+		mix(0, cost, 0)
+	}
+
+	return variants
+}
+
+// appendUnique appends s to list unless it is already present
+func appendUnique(list []string, s string) []string {
+	if containsString(list, s) {
+		return list
 	}
+	return append(list, s)
 }
 
-// ToPinyinInitial converts text to pinyin initials
-func ToPinyinInitial(text string) string {
-	processor := NewPinyinProcessor()
-	fullPinyin := processor.Process(text)
+// PinyinInitials returns the initialisms of a word, such as "nmsl" for 你妈死了
+// Every character with a reading is replaced by the first letter of a reading;
+// other characters are kept. Heteronyms contribute every distinct initial, with
+// the common reading first. It returns nil when no character has a reading, and
+// at most limit forms (DefaultPinyinLimit when limit is 0 or less)
+func PinyinInitials(word string, limit int) []string {
+	if limit <= 0 {
+		limit = DefaultPinyinLimit
+	}
+
+	results := []string{""}
+	readable := false
+	for _, r := range word {
+		initials := []string{string(r)}
+		if readings := Pinyin(r, PinyinPlain); len(readings) > 0 {
+			readable = true
+			initials = initials[:0]
+			for _, reading := range readings {
+				initials = appendUnique(initials, reading[:1])
+			}
+		}
 
-	// Extract first letter of each pinyin syllable
+		next := make([]string, 0, limit)
+		for _, prefix := range results {
+			for _, initial := range initials {
+				if len(next) == limit {
+					break
+				}
+				next = appendUnique(next, prefix+initial)
+			}
+		}
+		results = next
+	}
+
+	if !readable {
+		return nil
+	}
+	return results
+}
+
+// ToPinyinInitial converts text to pinyin initials, one letter per character
+// Characters without a reading are kept as they are
+func ToPinyinInitial(text string) string {
 	var result strings.Builder
-	words := strings.Fields(fullPinyin)
-	for _, word := range words {
-		if len(word) > 0 {
-			result.WriteByte(word[0])
+	for _, r := range text {
+		if readings := Pinyin(r, PinyinPlain); len(readings) > 0 {
+			result.WriteByte(readings[0][0])
+		} else {
+			result.WriteRune(r)
 		}
 	}
 
@@ -0,0 +1,186 @@
+package variant
+
+import (
+	"bytes"
+	"compress/flate"
+	_ "embed"
+	"encoding/binary"
+	"io"
+	"strings"
+	"sync"
+)
+
+// pinyinData is the compressed pinyin table generated by gen_pinyin.go
+//
+//go:embed pinyin.dat
+var pinyinData []byte
+
+// PinyinStyle selects how pinyin readings are spelled
+type PinyinStyle int
+
+const (
+	// PinyinPlain spells readings without tones, such as "zhong"
+	PinyinPlain PinyinStyle = iota
+	// PinyinToneNumber appends the tone as a digit, such as "zhong1"
+	// The neutral tone is written as 5 and ü as v
+	PinyinToneNumber
+)
+
+// DefaultPinyinLimit is the default maximum number of readings generated for a word
+const DefaultPinyinLimit = 16
+
+// pinyinPage holds the readings of 256 consecutive code points
+type pinyinPage struct {
+	tone  [256][]string
+	plain [256][]string
+}
+
+// pinyinTable decompresses pages of pinyinData on first use
+type pinyinTable struct {
+	once  sync.Once
+	index map[rune][2]uint32 // Page -> offset and length in data
+	data  []byte
+
+	mu    sync.RWMutex
+	pages map[rune]*pinyinPage
+}
+
+var pinyinTab = &pinyinTable{}
+
+// init parses the page index of the embedded table
+func (t *pinyinTable) init() {
+	t.index = make(map[rune][2]uint32)
+	t.pages = make(map[rune]*pinyinPage)
+
+	if len(pinyinData) < 6 || string(pinyinData[:4]) != "PYv1" {
+		return
+	}
+	count := int(binary.BigEndian.Uint16(pinyinData[4:6]))
+	header := 6 + count*10
+	if len(pinyinData) < header {
+		return
+	}
+
+	for i := 0; i < count; i++ {
+		entry := pinyinData[6+i*10:]
+		page := rune(binary.BigEndian.Uint16(entry))
+		t.index[page] = [2]uint32{binary.BigEndian.Uint32(entry[2:]), binary.BigEndian.Uint32(entry[6:])}
+	}
+	t.data = pinyinData[header:]
+}
+
+// page returns the readings of the page containing r, loading it if needed
+func (t *pinyinTable) page(r rune) *pinyinPage {
+	t.once.Do(t.init)
+
+	number := r >> 8
+	t.mu.RLock()
+	page, loaded := t.pages[number]
+	t.mu.RUnlock()
+	if loaded {
+		return page
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if page, loaded = t.pages[number]; loaded {
+		return page
+	}
+
+	page = t.load(number)
+	t.pages[number] = page
+	return page
+}
+
+// load decompresses one page; it returns nil for code points without readings
+func (t *pinyinTable) load(number rune) *pinyinPage {
+	location, exists := t.index[number]
+	if !exists || uint64(location[0])+uint64(location[1]) > uint64(len(t.data)) {
+		return nil
+	}
+
+	reader := flate.NewReader(bytes.NewReader(t.data[location[0] : location[0]+location[1]]))
+	defer reader.Close()
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil
+	}
+
+	page := &pinyinPage{}
+	for i, line := range strings.Split(string(raw), "\n") {
+		if i >= 256 {
+			break
+		}
+		if line == "" {
+			continue
+		}
+		page.tone[i] = strings.Split(line, ",")
+		for _, reading := range page.tone[i] {
+			plain := strings.TrimRight(reading, "12345")
+			if !containsString(page.plain[i], plain) {
+				page.plain[i] = append(page.plain[i], plain)
+			}
+		}
+	}
+	return page
+}
+
+// Pinyin returns the readings of a character, most common first
+// It returns nil for characters without a reading; the slice must not be modified
+func Pinyin(r rune, style PinyinStyle) []string {
+	if r < 0x3000 || r > 0x3FFFF {
+		return nil
+	}
+	page := pinyinTab.page(r)
+	if page == nil {
+		return nil
+	}
+	if style == PinyinToneNumber {
+		return page.tone[r&0xFF]
+	}
+	return page.plain[r&0xFF]
+}
+
+// PinyinReadings returns the readings of text as whole words, combining every
+// reading of each heteronym (多音字)
+// Characters without a reading are kept as they are. The first result uses the
+// most common reading of every character; at most limit results are returned
+// (DefaultPinyinLimit when limit is 0 or less)
+func PinyinReadings(text string, style PinyinStyle, limit int) []string {
+	if limit <= 0 {
+		limit = DefaultPinyinLimit
+	}
+
+	results := []string{""}
+	for _, r := range text {
+		readings := Pinyin(r, style)
+		if len(readings) == 0 {
+			readings = []string{string(r)}
+		}
+
+		next := make([]string, 0, limit)
+		for _, prefix := range results {
+			for _, reading := range readings {
+				if len(next) == limit {
+					break
+				}
+				combined := prefix + reading
+				if !containsString(next, combined) {
+					next = append(next, combined)
+				}
+			}
+		}
+		results = next
+	}
+	return results
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -1,17 +1,62 @@
 package variant
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// SimilarRule maps look-alike characters to the base character they imitate
+type SimilarRule struct {
+	Base     rune
+	Similars []rune
+}
 
 // SimilarProcessor handles similar character detection
 type SimilarProcessor struct {
-	similarMap map[rune][]rune
+	similarMap map[rune][]rune // Base character -> similar variants
+	baseOf     map[rune]rune   // Base or similar variant -> base character
 }
 
-// NewSimilarProcessor creates a new similar character processor
+// NewSimilarProcessor creates a new similar character processor with the built-in rules
 func NewSimilarProcessor() *SimilarProcessor {
-	return &SimilarProcessor{
-		similarMap: buildSimilarMap(),
+	// The built-in rules are free of conflicts, which TestSimilarRules checks
+	p, _ := NewSimilarProcessorWithRules()
+	return p
+}
+
+// NewSimilarProcessorWithRules creates a similar character processor with custom
+// rules added to the built-in ones
+// Custom rules take precedence: a character they claim leaves its built-in group,
+// and a built-in base they list as a similar character brings its group along,
+// so {'o', '0'} also maps '〇' to 'o'. It fails when the custom rules would
+// normalize a character to two different bases
+func NewSimilarProcessorWithRules(rules ...SimilarRule) (*SimilarProcessor, error) {
+	p := &SimilarProcessor{
+		similarMap: make(map[rune][]rune),
+		baseOf:     make(map[rune]rune),
+	}
+	for _, rule := range rules {
+		if err := p.AddSimilarRule(rule.Base, rule.Similars...); err != nil {
+			return nil, err
+		}
+	}
+
+	// The built-in rules are free of conflicts, so the order of the map does not matter
+	for base, similars := range buildSimilarMap() {
+		target := p.findBase(base)
+		if _, exists := p.baseOf[base]; !exists {
+			p.baseOf[base] = base
+		}
+		for _, similar := range similars {
+			if _, exists := p.baseOf[similar]; !exists && similar != target {
+				p.similarMap[target] = append(p.similarMap[target], similar)
+				p.baseOf[similar] = target
+			}
+		}
 	}
+	return p, nil
 }
 
 // Process normalizes similar characters to their base form
@@ -20,14 +65,18 @@ func (p *SimilarProcessor) Process(text string) string {
 	builder.Grow(len(text))
 
 	for _, r := range text {
-		// Find the base character for similar variants
-		base := p.findBase(r)
-		builder.WriteRune(base)
+		builder.WriteRune(p.findBase(r))
 	}
 
 	return builder.String()
 }
 
+// ProcessWithOffsets normalizes similar characters; characters map one to one
+func (p *SimilarProcessor) ProcessWithOffsets(text string) (string, []int) {
+	processed := p.Process(text)
+	return processed, identityOffsets(utf8.RuneCountInString(processed))
+}
+
 // Name returns the processor name
 func (p *SimilarProcessor) Name() string {
 	return "similar"
@@ -35,30 +84,47 @@ func (p *SimilarProcessor) Name() string {
 
 // findBase finds the base character for a given rune
 func (p *SimilarProcessor) findBase(r rune) rune {
-	// Check if this character is in any similarity group
-	for base, similars := range p.similarMap {
-		for _, similar := range similars {
-			if r == similar {
-				return base
-			}
-		}
-		if r == base {
-			return base
-		}
+	if base, exists := p.baseOf[r]; exists {
+		return base
 	}
 	return r
 }
 
 // AddSimilarRule adds a custom similar character rule
-func (p *SimilarProcessor) AddSimilarRule(base rune, similars ...rune) {
+// A character may belong to one base only, and a base may not itself imitate
+// another base; a conflicting rule is rejected as a whole
+func (p *SimilarProcessor) AddSimilarRule(base rune, similars ...rune) error {
 	if p.similarMap == nil {
 		p.similarMap = make(map[rune][]rune)
+		p.baseOf = make(map[rune]rune)
 	}
-	p.similarMap[base] = append(p.similarMap[base], similars...)
+
+	if existing, exists := p.baseOf[base]; exists && existing != base {
+		return fmt.Errorf("similar rule for %q conflicts: %q already maps to %q", base, base, existing)
+	}
+	for _, similar := range similars {
+		if existing, exists := p.baseOf[similar]; exists && existing != base {
+			return fmt.Errorf("similar rule for %q conflicts: %q already maps to %q", base, similar, existing)
+		}
+	}
+
+	p.baseOf[base] = base
+	for _, similar := range similars {
+		if similar == base {
+			continue
+		}
+		if _, exists := p.baseOf[similar]; !exists {
+			p.similarMap[base] = append(p.similarMap[base], similar)
+			p.baseOf[similar] = base
+		}
+	}
+	return nil
 }
 
 // buildSimilarMap builds a map of similar characters
 // Base character -> similar variants
+// A character belongs to one group only, so digits that stand for letters share
+// the letter's group, while Chinese numerals keep groups of their own script
 func buildSimilarMap() map[rune][]rune {
 	return map[rune][]rune{
 		// Original examples
@@ -67,31 +133,30 @@ func buildSimilarMap() map[rune][]rune {
 		'测': {'側', '厕'},
 		'草': {'艹', '屮', '荡'},
 
-		// Numbers and letters
-		'0': {'O', 'o', '〇', '零', '○'},
-		'1': {'l', 'I', 'i', '|', '一'},
+		// Numbers
+		'一': {'壹'},
+		'三': {'叁'},
+		'九': {'玖'},
 		'2': {'二', '貳'},
-		'3': {'三', '叁'},
 		'4': {'四', '肆'},
 		'5': {'五', '伍'},
 		'6': {'六', '陸'},
 		'7': {'七', '柒'},
 		'8': {'八', '捌'},
-		'9': {'九', '玖'},
 
-		// Common similar looking characters
+		// Common similar looking characters, with the digits imitating them
 		'a': {'@', 'α'},
-		'o': {'0', 'O', '〇'},
-		'i': {'l', '1', 'I', '|'},
+		'o': {'0', 'O', 'ο', '〇', '○'},
+		'i': {'1', 'l', 'I', '|'},
 		's': {'$', '§'},
 		'e': {'3', 'ε'},
-		'g': {'9', 'q'},
+		'g': {'9', 'ɡ'},
 
 		// Chinese character similarities
 		'日': {'曰', '目'},
 		'土': {'士', '壬'},
 		'刀': {'力', '刃'},
-		'人': {'入', '八'},
+		'人': {'入'},
 		'千': {'干', '于'},
 		'未': {'末', '朱'},
 		'己': {'已', '巳'},
@@ -109,10 +174,15 @@ func buildSimilarMap() map[rune][]rune {
 	}
 }
 
-// IsSimilar checks if two characters are similar
+var (
+	defaultSimilarOnce sync.Once
+	defaultSimilar     *SimilarProcessor
+)
+
+// IsSimilar checks if two characters are similar under the built-in rules
 func IsSimilar(r1, r2 rune) bool {
-	processor := NewSimilarProcessor()
-	base1 := processor.findBase(r1)
-	base2 := processor.findBase(r2)
-	return base1 == base2
+	defaultSimilarOnce.Do(func() {
+		defaultSimilar = NewSimilarProcessor()
+	})
+	return defaultSimilar.findBase(r1) == defaultSimilar.findBase(r2)
 }
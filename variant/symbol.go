@@ -3,6 +3,7 @@ package variant
 import (
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // SymbolProcessor handles symbol interference filtering
@@ -39,6 +40,31 @@ func (p *SymbolProcessor) Process(text string) string {
 	return builder.String()
 }
 
+// ProcessWithOffsets removes symbols like Process and maps the kept runes to their input positions
+func (p *SymbolProcessor) ProcessWithOffsets(text string) (string, []int) {
+	if !p.removeSymbols {
+		return text, identityOffsets(utf8.RuneCountInString(text))
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(text))
+	offsets := make([]int, 0, len(text))
+
+	i := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || isCJK(r) {
+			builder.WriteRune(r)
+			offsets = append(offsets, i)
+		} else if unicode.IsSpace(r) {
+			builder.WriteRune(' ')
+			offsets = append(offsets, i)
+		}
+		i++
+	}
+
+	return builder.String(), offsets
+}
+
 // Name returns the processor name
 func (p *SymbolProcessor) Name() string {
 	return "symbol"
@@ -9,4 +9,21 @@ type Processor interface {
 	Name() string
 }
 
+// Mapper is implemented by processors that can report where their output came from
+// The detector uses it to map matches on processed text back to the original text
+type Mapper interface {
+	Processor
 
+	// ProcessWithOffsets transforms the text like Process and also returns, for every
+	// rune of the output, the index of the input rune it was produced from
+	ProcessWithOffsets(text string) (string, []int)
+}
+
+// identityOffsets returns the offsets of a processor that maps runes one to one
+func identityOffsets(n int) []int {
+	offsets := make([]int, n)
+	for i := range offsets {
+		offsets[i] = i
+	}
+	return offsets
+}
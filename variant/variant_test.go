@@ -1,6 +1,9 @@
 package variant
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestPinyinProcessor_Process(t *testing.T) {
 	processor := NewPinyinProcessor()
@@ -121,3 +124,360 @@ func TestIsSimilar(t *testing.T) {
 }
 
 
+
+func TestProcessWithOffsets(t *testing.T) {
+	tests := []struct {
+		name      string
+		processor Mapper
+		input     string
+		expected  string
+		offsets   []int
+	}{
+		{"Symbol removes runes", NewSymbolProcessor(), "敏@感 词", "敏感 词", []int{0, 2, 3, 4}},
+		{"Pinyin expands runes", NewPinyinProcessor(), "测a", "cea", []int{0, 0, 1}},
+		{"Traditional maps one to one", NewTraditionalProcessor(), "測試", "测试", []int{0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, offsets := tt.processor.ProcessWithOffsets(tt.input)
+			if result != tt.expected || result != tt.processor.Process(tt.input) {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+			if fmt.Sprint(offsets) != fmt.Sprint(tt.offsets) {
+				t.Errorf("Expected offsets %v, got %v", tt.offsets, offsets)
+			}
+		})
+	}
+}
+
+func TestPinyin(t *testing.T) {
+	tests := []struct {
+		input    rune
+		style    PinyinStyle
+		expected []string
+	}{
+		{'傻', PinyinPlain, []string{"sha"}},
+		{'傻', PinyinToneNumber, []string{"sha3"}},
+		{'重', PinyinPlain, []string{"zhong", "chong", "tong"}},
+		{'了', PinyinToneNumber, []string{"le5", "liao3", "liao4"}},
+		{'𠀀', PinyinPlain, []string{"he"}},
+		{'A', PinyinPlain, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.input), func(t *testing.T) {
+			result := Pinyin(tt.input, tt.style)
+			if fmt.Sprint(result) != fmt.Sprint(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPinyinReadings(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		style    PinyinStyle
+		limit    int
+		expected []string
+	}{
+		{"Heteronyms", "重行", PinyinPlain, 4, []string{"zhongxing", "zhonghang", "zhongheng", "chongxing"}},
+		{"Tone numbers", "傻比", PinyinToneNumber, 0, []string{"sha3bi3", "sha3bi4", "sha3pi2", "sha3pi3"}},
+		{"Mixed", "傻B", PinyinPlain, 0, []string{"shaB"}},
+		{"Duplicates removed", "得", PinyinPlain, 0, []string{"de", "dei"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PinyinReadings(tt.input, tt.style, tt.limit)
+			if fmt.Sprint(result) != fmt.Sprint(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPinyinVariants(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		limit    int
+		expected []string
+	}{
+		{"Full and mixed forms", "傻比", 0, []string{"shabi", "shapi", "傻bi", "sha比", "傻b", "s比", "傻pi", "傻p"}},
+		{"Limited", "傻比", 4, []string{"shabi", "shapi", "傻bi", "sha比"}},
+		{"Non-Chinese characters kept", "傻B", 0, []string{"shaB"}},
+		{"No readings", "abc", 0, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PinyinVariants(tt.input, tt.limit)
+			if fmt.Sprint(result) != fmt.Sprint(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPinyinInitials(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"Per character", "你妈死了", []string{"nmsl"}},
+		{"Heteronyms", "傻比", []string{"sb", "sp"}},
+		{"Other characters kept", "傻B", []string{"sB"}},
+		{"No readings", "abc", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PinyinInitials(tt.input, 0)
+			if fmt.Sprint(result) != fmt.Sprint(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	if result := ToPinyinInitial("你妈 死了!"); result != "nm sl!" {
+		t.Errorf("Expected %q, got %q", "nm sl!", result)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		conversion Conversion
+		expected   string
+	}{
+		{"Phrases to traditional", "头发和发展", ConvertS2T, "頭髮和發展"},
+		{"Phrases to simplified", "頭髮與發展", ConvertT2S, "头发与发展"},
+		{"Taiwan variants", "着裏台湾", ConvertS2TW, "著裡臺灣"},
+		{"Taiwan vocabulary", "软件和鼠标", ConvertS2TWP, "軟體和滑鼠"},
+		{"Taiwan vocabulary to simplified", "軟體與滑鼠", ConvertTW2SP, "软件与鼠标"},
+		{"Hong Kong variants", "衞生", ConvertHK2S, "卫生"},
+		{"Other text kept", "abc 123", ConvertT2S, "abc 123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Convert(tt.input, tt.conversion)
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+
+	// Text that is already simplified passes through every conversion to simplified
+	for _, conversion := range []Conversion{ConvertT2S, ConvertTW2S, ConvertTW2SP, ConvertHK2S} {
+		for _, text := range []string{"不主动怎么交女朋友", "什么什么大冒险", "台湾人吃饭温度户口"} {
+			if result := Convert(text, conversion); result != text {
+				t.Errorf("Expected %s to keep %q, got %q", conversion, text, result)
+			}
+		}
+	}
+
+	// 隨身碟 (3 runes) becomes U盘 (2 runes) and still spans the whole phrase
+	output, offsets := ConvertWithOffsets("買隨身碟", ConvertTW2SP)
+	if output != "买U盘" || fmt.Sprint(offsets) != "[0 1 3]" {
+		t.Errorf("Expected 买U盘 with offsets [0 1 3], got %q %v", output, offsets)
+	}
+}
+
+func TestNormalizeProcessor(t *testing.T) {
+	processor := NewNormalizeProcessor()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		offsets  string
+	}{
+		{"Full-width", "ｂａｄ", "bad", "[0 1 2]"},
+		{"Circled", "ⓑⓐⓓ", "bad", "[0 1 2]"},
+		{"Mathematical", "𝐛𝐚𝐝", "bad", "[0 1 2]"},
+		{"Stacked combining marks", "B̸̢͉A̷D", "bad", "[0 4 6]"},
+		{"Precomposed diacritics", "Crème Brûlée", "creme brulee", "[0 1 2 3 4 5 6 7 8 9 10 11]"},
+		{"Full case folding", "Straße", "strasse", "[0 1 2 3 4 4 5]"},
+		{"Ligatures", "ﬁne", "fine", "[0 0 1 2]"},
+		{"Kana and Hangul kept", "がんばれ 한국어", "がんばれ 한국어", "[0 1 2 3 4 5 6 7]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, offsets := processor.ProcessWithOffsets(tt.input)
+			if result != tt.expected || fmt.Sprint(offsets) != tt.offsets {
+				t.Errorf("Expected %q %s, got %q %v", tt.expected, tt.offsets, result, offsets)
+			}
+			if processor.Process(tt.input) != result {
+				t.Errorf("Process and ProcessWithOffsets disagree on %q", tt.input)
+			}
+		})
+	}
+}
+
+func TestInvisibleProcessor(t *testing.T) {
+	processor := NewInvisibleProcessor()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		offsets  string
+	}{
+		{"Zero-width characters", "b\u200ba\u200c\u200dd\ufeff", "bad", "[0 2 5]"},
+		{"Variation selectors", "敏\ufe0f感\U000E0101词", "敏感词", "[0 2 4]"},
+		{"Bidi controls", "\u202ebad\u202c", "bad", "[1 2 3]"},
+		{"Tag characters", "b\U000E0061ad", "bad", "[0 2 3]"},
+		{"Punctuation kept", "Hi, there! 你好。", "Hi, there! 你好。", "[0 1 2 3 4 5 6 7 8 9 10 11 12 13]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, offsets := processor.ProcessWithOffsets(tt.input)
+			if result != tt.expected || fmt.Sprint(offsets) != tt.offsets {
+				t.Errorf("Expected %q %s, got %q %v", tt.expected, tt.offsets, result, offsets)
+			}
+			if RemoveInvisible(tt.input) != result {
+				t.Errorf("RemoveInvisible and ProcessWithOffsets disagree on %q", tt.input)
+			}
+		})
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	// Evasions seen in spam, using Cyrillic, Greek and Cherokee look-alikes
+	tests := []struct {
+		name      string
+		input     string
+		crossOnly bool
+		expected  string
+	}{
+		{"Cyrillic a", "pаypаl", false, "paypal"},
+		{"Greek capitals", "ΡΟRΝ", false, "PORN"},
+		{"Cyrillic and Ukrainian", "сlіck hеrе", false, "click here"},
+		{"Cherokee", "Ꮪex", false, "Sex"},
+		{"Cyrillic o", "bitcоin", true, "bitcoin"},
+		{"Digit one", "v1agra", false, "vlagra"},
+		{"Digit one kept across scripts only", "v1agra", true, "v1agra"},
+		{"Latin rn", "same", false, "sarne"},
+		{"Latin rn kept across scripts only", "same", true, "same"},
+		{"Chinese kept", "敏感词", false, "敏感词"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Skeleton(tt.input, tt.crossOnly)
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+
+			processed, offsets := NewCaseSensitiveConfusableProcessor(tt.crossOnly).ProcessWithOffsets(tt.input)
+			if processed != result || len(offsets) != len([]rune(result)) {
+				t.Errorf("ProcessWithOffsets returned %q %v", processed, offsets)
+			}
+		})
+	}
+}
+
+func TestSimilarRules(t *testing.T) {
+	// The built-in table must be free of conflicts
+	builtin := &SimilarProcessor{}
+	for base, similars := range buildSimilarMap() {
+		if err := builtin.AddSimilarRule(base, similars...); err != nil {
+			t.Fatalf("Built-in similar rules conflict: %v", err)
+		}
+	}
+
+	processor := NewSimilarProcessor()
+	for i := 0; i < 20; i++ {
+		if result := processor.Process("煞笔 0〇 b4dw0rd g9"); result != "傻比 oo b4dword gg" {
+			t.Fatalf("Expected %q, got %q", "傻比 oo b4dword gg", result)
+		}
+	}
+	if IsSimilar('g', 'q') || IsSimilar('人', '八') {
+		t.Errorf("Expected g/q and 人/八 not to be similar")
+	}
+
+	// Chinese numerals never turn into Latin letters
+	if result := processor.Process("一三九零玖叁"); result != "一三九零九三" {
+		t.Errorf("Expected %q, got %q", "一三九零九三", result)
+	}
+
+	tests := []struct {
+		name     string
+		rules    []SimilarRule
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{"New base", []SimilarRule{{Base: '鸡', Similars: []rune{'鷄', '机'}}}, "鷄机", "鸡鸡", false},
+		{"Extends a base", []SimilarRule{{Base: 'a', Similars: []rune{'а'}}}, "а@", "aa", false},
+		{"Same as built-in", []SimilarRule{{Base: 'o', Similars: []rune{'0', '〇'}}}, "0〇○", "ooo", false},
+		{"Takes a built-in similar", []SimilarRule{{Base: 'q', Similars: []rune{'9'}}}, "9gɡ", "qgg", false},
+		{"Takes a built-in base with its group", []SimilarRule{{Base: 'B', Similars: []rune{'8'}}}, "8八捌", "BBB", false},
+		{"Built-in similar as base", []SimilarRule{{Base: '煞', Similars: []rune{'杀'}}}, "煞杀傻", "煞煞傻", false},
+		{"Custom rules conflict", []SimilarRule{{Base: 'a', Similars: []rune{'@'}}, {Base: 'b', Similars: []rune{'@'}}}, "", "", true},
+		{"Custom base imitates another", []SimilarRule{{Base: 'a', Similars: []rune{'@'}}, {Base: '@', Similars: []rune{'⍺'}}}, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			custom, err := NewSimilarProcessorWithRules(tt.rules...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && custom.Process(tt.input) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, custom.Process(tt.input))
+			}
+		})
+	}
+}
+
+func TestFoldedSkeleton(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		crossOnly bool
+		expected  string
+	}{
+		{"Latin capitals", "KILL Idiot", false, "kill idiot"},
+		{"Latin capitals across scripts only", "KILL", true, "kill"},
+		{"Cyrillic capitals", "ВОТ", false, "bot"},
+		{"Greek capitals", "ΡΟRΝ", false, "porn"},
+		{"Cyrillic I", "KІLL", true, "kill"},
+		{"Greek iota", "ΙDΙΟΤ", false, "idiot"},
+		{"Cyrillic lower case", "pаypаl", true, "paypal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FoldedSkeleton(tt.input, tt.crossOnly)
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+
+			processed, offsets := NewConfusableProcessor(tt.crossOnly).ProcessWithOffsets(tt.input)
+			if processed != result || len(offsets) != len([]rune(result)) {
+				t.Errorf("ProcessWithOffsets returned %q %v", processed, offsets)
+			}
+		})
+	}
+}
+
+func BenchmarkTraditionalProcessor_Parallel(b *testing.B) {
+	processor := NewTraditionalProcessor()
+	text := "這是一段用於測試繁體轉換的文本，頭髮和發展都要正確轉換。"
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			processor.ProcessWithOffsets(text)
+		}
+	})
+}
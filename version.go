@@ -0,0 +1,200 @@
+package gosensitive
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Karrecy/sensitive-go/algorithm"
+	"github.com/Karrecy/sensitive-go/algorithm/ac"
+	"github.com/Karrecy/sensitive-go/algorithm/dfa"
+	"github.com/Karrecy/sensitive-go/dict"
+)
+
+// Version describes one compiled dictionary version
+type Version struct {
+	ID          uint64    // Sequential version number, starting at 1
+	Fingerprint string    // Order-independent fingerprint of the word set
+	LoadedAt    time.Time // When the version was compiled
+	WordCount   int       // Number of words in the version
+	Source      string    // What produced the version: build, reload or changeset
+}
+
+// snapshot is a retained compiled dictionary version
+type snapshot struct {
+	version   Version
+	matcher   algorithm.Matcher
+	words     []dict.Word
+	truncated []string // Words with substituted spellings beyond SubstitutionLimit
+}
+
+// substituter is implemented by matchers that accept a substitution table
+type substituter interface {
+	SetSubstitutions(table algorithm.Substitutions, limit int)
+}
+
+// collapser is implemented by matchers that can collapse repeated characters
+type collapser interface {
+	SetMaxRun(maxRun int)
+}
+
+// newMatcher creates a matcher for the algorithm, choosing by word count when auto
+func newMatcher(algorithmType AlgorithmType, caseSensitive bool, wordCount int) algorithm.Matcher {
+	switch algorithmType {
+	case AlgorithmDFA:
+		return dfa.NewDFAMatcher(caseSensitive)
+	case AlgorithmAC:
+		return ac.NewACMatcher(caseSensitive)
+	default:
+		if wordCount < 5000 {
+			return dfa.NewDFAMatcher(caseSensitive)
+		}
+		return ac.NewACMatcher(caseSensitive)
+	}
+}
+
+// install compiles words into a new version and swaps it in atomically
+// Callers must hold reloadMu (or own the detector exclusively, as Build does)
+func (d *Detector) install(words []dict.Word, source string) error {
+	compiled := d.options.expandWords(words)
+	matcher := newMatcher(d.options.Algorithm, d.options.CaseSensitive, len(compiled))
+	var truncated []string
+	if d.options.EnableSubstitution {
+		if s, ok := matcher.(substituter); ok {
+			table := d.options.Substitutions
+			if table == nil {
+				table = algorithm.DefaultSubstitutions()
+			}
+			s.SetSubstitutions(table, d.options.SubstitutionLimit)
+			truncated = d.options.truncatedSubstitutions(table, compiled)
+		}
+	}
+	if d.options.EnableRepeatCollapse {
+		if c, ok := matcher.(collapser); ok {
+			maxRun := d.options.MaxRepeatRun
+			if maxRun <= 0 {
+				maxRun = algorithm.DefaultMaxRun
+			}
+			c.SetMaxRun(maxRun)
+		}
+	}
+	if err := matcher.Build(compiled); err != nil {
+		return err
+	}
+
+	snap := &snapshot{
+		version: Version{
+			ID:          d.nextID + 1,
+			Fingerprint: dict.Fingerprint(words),
+			LoadedAt:    time.Now(),
+			WordCount:   len(words),
+			Source:      source,
+		},
+		matcher:   matcher,
+		words:     words,
+		truncated: truncated,
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.nextID = snap.version.ID
+	d.history = append(d.history, snap)
+	d.activate(snap)
+	d.trimHistory()
+
+	return nil
+}
+
+// truncatedSubstitutions returns the words whose spellings under table exceed
+// SubstitutionLimit, each once, as the matchers see them
+func (o *Options) truncatedSubstitutions(table algorithm.Substitutions, words []dict.Word) []string {
+	var truncated []string
+	seen := make(map[string]bool)
+	for _, w := range words {
+		text := w.Text
+		if !o.CaseSensitive {
+			text = strings.ToLower(text)
+		}
+		if table.Truncated(text, o.SubstitutionLimit) && !seen[w.Canonical()] {
+			seen[w.Canonical()] = true
+			truncated = append(truncated, w.Canonical())
+		}
+	}
+	return truncated
+}
+
+// activate makes snap the current version; callers must hold mu
+func (d *Detector) activate(snap *snapshot) {
+	d.matcher = snap.matcher
+	d.words = snap.words
+	d.version = snap.version
+}
+
+// trimHistory drops the oldest versions beyond MaxVersions, never the current one
+func (d *Detector) trimHistory() {
+	limit := d.options.MaxVersions
+	if limit < 1 {
+		limit = 1
+	}
+
+	for len(d.history) > limit {
+		drop := 0
+		if d.history[0].version.ID == d.version.ID {
+			drop = 1
+		}
+		d.history = append(d.history[:drop], d.history[drop+1:]...)
+	}
+}
+
+// CurrentVersion returns the version of the dictionary currently in use
+func (d *Detector) CurrentVersion() Version {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.version
+}
+
+// TruncatedSubstitutions returns the words of the current version with more
+// substituted spellings than SubstitutionLimit; only the spellings with the
+// fewest substitutions are matched for them
+func (d *Detector) TruncatedSubstitutions() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for _, snap := range d.history {
+		if snap.version.ID == d.version.ID {
+			return append([]string(nil), snap.truncated...)
+		}
+	}
+	return nil
+}
+
+// Versions returns the retained dictionary versions, oldest first
+func (d *Detector) Versions() []Version {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	versions := make([]Version, len(d.history))
+	for i, snap := range d.history {
+		versions[i] = snap.version
+	}
+	return versions
+}
+
+// Rollback swaps a retained version's compiled matcher back in atomically
+// Later versions stay in the history, so a rollback can itself be undone
+func (d *Detector) Rollback(id uint64) error {
+	d.reloadMu.Lock()
+	defer d.reloadMu.Unlock()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, snap := range d.history {
+		if snap.version.ID == id {
+			d.activate(snap)
+			return nil
+		}
+	}
+	return fmt.Errorf("version %d is not retained", id)
+}
@@ -117,3 +117,83 @@ func (w *FileWatcher) IsRunning() bool {
 	defer w.mu.Unlock()
 	return w.running
 }
+
+// SQLWatcher polls a SQL source and refreshes the detector when its rows change
+type SQLWatcher struct {
+	detector *Detector
+	loader   *loader.SQLLoader
+	interval time.Duration
+	stopCh   chan struct{}
+	mu       sync.Mutex
+	running  bool
+}
+
+// NewSQLWatcher creates a new SQL watcher
+func NewSQLWatcher(detector *Detector, loader *loader.SQLLoader, interval time.Duration) *SQLWatcher {
+	return &SQLWatcher{
+		detector: detector,
+		loader:   loader,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start begins polling the source for changes
+func (w *SQLWatcher) Start() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return nil
+	}
+	w.running = true
+
+	go w.watch()
+	return nil
+}
+
+// Stop stops the SQL watcher
+func (w *SQLWatcher) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+}
+
+// watch polls the source on every tick
+func (w *SQLWatcher) watch() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.Check()
+		case <-w.stopCh:
+			return
+		}
+	}
+}
+
+// Check polls the source once and refreshes the detector if rows changed
+// Every source is merged again, so words from other sources are kept
+func (w *SQLWatcher) Check() error {
+	_, changed, err := w.loader.Poll()
+	if err != nil || !changed {
+		// Failed to poll, keep using current words
+		return err
+	}
+	return w.detector.Refresh()
+}
+
+// IsRunning returns whether the watcher is currently running
+func (w *SQLWatcher) IsRunning() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.running
+}